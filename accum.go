@@ -0,0 +1,123 @@
+package interval
+
+import "math"
+
+// An Accumulator evaluates a sequence of arithmetic operations
+// on a running interval. Each operation rounds the result's endpoints
+// outward once and updates the Accumulator in place,
+// without allocating an intermediate Interval.
+// The Accumulator type's zero value holds the empty interval;
+// use NewAccumulator to start from a non-empty interval.
+type Accumulator struct {
+	in Interval
+}
+
+// NewAccumulator returns a pointer to an Accumulator holding a copy of in.
+func NewAccumulator(in *Interval) *Accumulator { return &Accumulator{*in} }
+
+// Result returns a copy of the interval held by acc.
+func (acc *Accumulator) Result() *Interval {
+	if acc.in.IsEmpty() {
+		return empty()
+	}
+	in := acc.in
+	return &in
+}
+
+// Add replaces the interval x held by acc with the sum x+y,
+// as by the function Add but rounded outward, and returns acc.
+func (acc *Accumulator) Add(y *Interval) *Accumulator {
+	x := &acc.in
+	if x.IsEmpty() || y.IsEmpty() {
+		return acc.setEmpty()
+	}
+	return acc.set(addDown(x.a, y.a), addUp(x.b, y.b), x.ends&y.ends)
+}
+
+// Sub replaces the interval x held by acc with the difference x-y,
+// as by the function Sub but rounded outward, and returns acc.
+func (acc *Accumulator) Sub(y *Interval) *Accumulator {
+	x := &acc.in
+	if x.IsEmpty() || y.IsEmpty() {
+		return acc.setEmpty()
+	}
+	return acc.set(addDown(x.a, -y.b), addUp(x.b, -y.a), x.ends&y.ends.flip())
+}
+
+// Mul replaces the interval x held by acc with the product x*y,
+// as by the function Mul but rounded outward, and returns acc.
+func (acc *Accumulator) Mul(y *Interval) *Accumulator {
+	x := &acc.in
+	if x.IsEmpty() || y.IsEmpty() {
+		return acc.setEmpty()
+	}
+	// As in Mul3, the extrema are among the products of endpoints,
+	// and an extremum is attained if some product attaining it is
+	// of two closed endpoints or of a closed zero endpoint.
+	xs := [2]float64{x.a, x.b}
+	ys := [2]float64{y.a, y.b}
+	xc := [2]bool{x.LeftIsClosed(), x.RightIsClosed()}
+	yc := [2]bool{y.LeftIsClosed(), y.RightIsClosed()}
+	var lo, hi, vlo, vhi float64
+	var loClosed, hiClosed bool
+	for i, p := range xs {
+		for j, q := range ys {
+			attained := xc[i] && yc[j] || p == 0 && xc[i] || q == 0 && yc[j]
+			var v float64
+			if p != 0 && q != 0 {
+				v = p * q
+			}
+			d, u := mulDown(p, q), -mulDown(-p, q)
+			if i == 0 && j == 0 {
+				vlo, vhi, lo, hi, loClosed, hiClosed = v, v, d, u, attained, attained
+				continue
+			}
+			switch {
+			case v < vlo:
+				vlo, lo, loClosed = v, d, attained
+			case v == vlo:
+				lo, loClosed = math.Min(lo, d), loClosed || attained
+			}
+			switch {
+			case v > vhi:
+				vhi, hi, hiClosed = v, u, attained
+			case v == vhi:
+				hi, hiClosed = math.Max(hi, u), hiClosed || attained
+			}
+		}
+	}
+	return acc.set(lo, hi, EndsFromBools(loClosed, hiClosed))
+}
+
+// set sets the interval held by acc to have endpoints a and b
+// and Ends e, except that infinite endpoints are open, and returns acc.
+func (acc *Accumulator) set(a, b float64, e Ends) *Accumulator {
+	acc.in = Interval{a, b, e & closedEnds(a, b)}
+	return acc
+}
+
+// setEmpty sets the interval held by acc to the empty interval and returns acc.
+func (acc *Accumulator) setEmpty() *Accumulator {
+	acc.in = Interval{}
+	return acc
+}
+
+// mulDown returns p*q rounded toward negative infinity.
+// A product with a zero factor is zero, even if the other factor is infinite.
+func mulDown(p, q float64) float64 {
+	if p == 0 || q == 0 {
+		return 0
+	}
+	v := p * q
+	switch {
+	case v == 0:
+		// The exact product is a nonzero value that underflowed.
+		if math.Signbit(p) != math.Signbit(q) {
+			return -math.SmallestNonzeroFloat64
+		}
+		return 0
+	case math.FMA(p, q, -v) < 0:
+		return math.Nextafter(v, neginf)
+	}
+	return v
+}
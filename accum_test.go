@@ -0,0 +1,111 @@
+package interval
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestAccumulator(t *testing.T) {
+	// (([1, 2] + [-2, 4]) * [-8, -4]) - [0, 0.5] with exact endpoints
+	got := NewAccumulator(inp1).Add(inm).Mul(inn1).Sub(inp0).Result()
+	if want := Sub(Mul(Add(inp1, inm), inn1), inp0); !Equal(got, want) {
+		t.Errorf("Accumulator: got %v, want %v", got, want)
+	}
+
+	acc := NewAccumulator(&Interval{0.1, 0.2, Closed}).Add(&Interval{0.2, 0.2, Closed})
+	if got, want := acc.Result(), (&Interval{0.3, 0.4, Closed}); !Equal(got, want) {
+		t.Errorf("Accumulator [0.1, 0.2] + [0.2, 0.2]: got %v, want %v", got, want)
+	}
+	if got, want := acc.Mul(&Interval{3, 3, Closed}).Result(), (&Interval{0.8999999999999999, 1.2000000000000002, Closed}); !Equal(got, want) {
+		t.Errorf("Accumulator ([0.1, 0.2] + [0.2, 0.2]) * [3, 3]: got %v, want %v", got, want)
+	}
+
+	for _, test := range []struct {
+		name string
+		acc  *Accumulator
+		want *Interval
+	}{
+		{"zero value", &Accumulator{}, ine},
+		{"empty operand", NewAccumulator(inp1).Add(ine).Add(inp1), ine},
+		{"overflow", NewAccumulator(&Interval{math.MaxFloat64, math.MaxFloat64, Closed}).Add(&Interval{math.MaxFloat64, math.MaxFloat64, Closed}), &Interval{math.MaxFloat64, inf, LeftClosed}},
+		{"unbounded", NewAccumulator(inpi).Mul(inni), &Interval{neginf, -1, RightClosed}},
+		{"zero times unbounded", NewAccumulator(inz).Mul(inr), inz},
+		{"open", NewAccumulator(&Interval{0, 1, RightClosed}).Mul(&Interval{2, 3, Open}), &Interval{0, 3, Open}},
+		{"underflow", NewAccumulator(&Interval{1e-200, 1e-200, Closed}).Mul(&Interval{-1e-200, -1e-200, Closed}), &Interval{-math.SmallestNonzeroFloat64, 0, Closed}},
+	} {
+		if got := test.acc.Result(); !Equal(got, test.want) {
+			t.Errorf("Accumulator %s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestAccumulatorEncloses(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		x := RandomInterval(r)
+		acc, comp := NewAccumulator(x), x
+		xs := samples(r, x, 5)
+		exact := make([]*big.Float, len(xs))
+		for k, v := range xs {
+			exact[k] = new(big.Float).SetPrec(2000).SetFloat64(v)
+		}
+		for step := 0; step < 4; step++ {
+			y := RandomInterval(r)
+			ys := samples(r, y, len(xs))
+			for k := range exact {
+				yk := new(big.Float).SetFloat64(ys[k])
+				switch step % 3 {
+				case 0:
+					exact[k].Add(exact[k], yk)
+				case 1:
+					exact[k].Sub(exact[k], yk)
+				case 2:
+					if exact[k].IsInf() && yk.Sign() == 0 || yk.IsInf() && exact[k].Sign() == 0 {
+						exact[k].SetInt64(0)
+					} else {
+						exact[k].Mul(exact[k], yk)
+					}
+				}
+			}
+			switch step % 3 {
+			case 0:
+				acc.Add(y)
+				comp = Add(comp, y)
+			case 1:
+				acc.Sub(y)
+				comp = Sub(comp, y)
+			case 2:
+				acc.Mul(y)
+				comp = Mul(comp, y)
+			}
+		}
+		got := acc.Result()
+		// Rounding outward at each step gives a result at least as wide
+		// as rounding to nearest.
+		if !Includes(got.Closure(), comp) {
+			t.Errorf("Accumulator from %v: got %v, want a superset of %v", x, got, comp)
+		}
+		lo, hi := got.BigFloats()
+		for _, v := range exact {
+			if v.IsInf() {
+				continue
+			}
+			if lo.Cmp(v) > 0 || hi.Cmp(v) < 0 {
+				t.Errorf("Accumulator from %v: got %v, which does not enclose %v", x, got, v)
+			}
+		}
+	}
+}
+
+func BenchmarkAccumulator(b *testing.B) {
+	x, y := &Interval{1, 2, Closed}, &Interval{-0.5, 0.25, LeftClosed}
+	b.ReportAllocs()
+	for b.Loop() {
+		acc := NewAccumulator(x)
+		for range 10 {
+			acc.Add(y).Mul(y).Sub(x)
+		}
+	}
+}
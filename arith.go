@@ -3,6 +3,8 @@ package interval
 import (
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 )
 
 // ErrDisjointUnion is returned when the result of a call to Div
@@ -15,12 +17,20 @@ var ErrDisjointUnion = errors.New("union of disjoint intervals")
 // the closed degenerate interval [0, 0].
 var ErrDivByZero = errors.New("division by the zero interval")
 
+// ErrOverflow is returned when a finite endpoint of the result
+// of an arithmetic operation overflows to an infinity.
+var ErrOverflow = errors.New("endpoint overflow")
+
+// ErrLength is returned when a function is called with
+// slice arguments whose lengths do not match.
+var ErrLength = errors.New("mismatched lengths")
+
 func (e Ends) flip() Ends { return e&leftEndMask<<1 + e&rightEndMask>>1 }
 
 // Classification functions after Hickey et al.: P contains at least one positive
 // number and no negative numbers; P0 contains 0 and P1 does not. Likewise for N.
 func (in *Interval) isP0() bool  { return in.a == 0 && in.b > 0 && in.LeftIsClosed() }
-func (in *Interval) isP1() bool  { return in.b > 0 && !in.Contains(0) }
+func (in *Interval) isP1() bool  { return in.b > 0 && !in.ContainsZero() }
 func (in *Interval) isPos() bool { return in.isP0() || in.isP1() }
 func (in *Interval) isN0() bool  { return in.Neg().isP0() }
 func (in *Interval) isN1() bool  { return in.Neg().isP1() }
@@ -30,30 +40,92 @@ func (in *Interval) isNeg() bool { return in.Neg().isPos() }
 func (in *Interval) has0() bool { return in.isP0() || in.IsMixed() || in.isN0() }
 
 // Neg returns the additive inverse of x.
+//
+// Special case is:
+//	Neg(empty) = empty
 func (in *Interval) Neg() *Interval {
-	return &Interval{-in.b, -in.a, in.ends.flip()}
+	n := in.neg()
+	return &n
+}
+
+// neg returns the additive inverse of in as a value.
+func (in Interval) neg() Interval {
+	if in.IsEmpty() {
+		return Interval{}
+	}
+	return Interval{-in.b, -in.a, in.ends.flip()}
 }
 
 // Add returns the sum x+y.
 //
+// The sum of non-empty intervals is never empty. Because rounding to nearest
+// is monotone, the result's left endpoint never exceeds its right endpoint,
+// but the two may round to the same value when the exact sum is a proper
+// interval with an open end. In that case, if a is finite, the result is the closed single
+// point [a, a] rather than the empty interval [a, a).
+//
+// An endpoint sum of finite magnitude greater than math.MaxFloat64 overflows.
+// A right endpoint that overflows to +Inf, or a left endpoint that overflows
+// to -Inf, makes the result unbounded on that side, which is a sound but
+// uninformative bound; use AddChecked to detect this. A left endpoint that
+// overflows to +Inf is instead replaced by an open endpoint at math.MaxFloat64,
+// and likewise for a right endpoint that overflows to -Inf,
+// so that the result is never empty.
+//
 // Special case is:
 //	Add(x, y) = empty if x or y is empty
 func Add(x, y *Interval) *Interval {
+	in := add(*x, *y)
+	return &in
+}
+
+// add returns the sum x+y as a value.
+func add(x, y Interval) Interval {
 	if x.IsEmpty() || y.IsEmpty() {
-		return empty()
+		return Interval{}
+	}
+	a, b, e := x.a+y.a, x.b+y.b, x.ends&y.ends
+	if a == b && !math.IsInf(a, 0) {
+		e = Closed
+	}
+	// The exact sum of endpoints that overflow lies beyond ±MaxFloat64.
+	if a == inf {
+		a, e = math.MaxFloat64, e&^leftEndMask
+	}
+	if b == neginf {
+		b, e = -math.MaxFloat64, e&^rightEndMask
+	}
+	return Interval{a, b, e & closedEnds(a, b)}
+}
+
+// AddChecked returns the sum x+y as computed by Add. It also returns ErrOverflow
+// if an endpoint of the sum overflowed to an infinity although the
+// corresponding endpoints of x and y are finite, which distinguishes a result
+// that is unbounded because of overflow from one that is genuinely unbounded.
+func AddChecked(x, y *Interval) (*Interval, error) {
+	in := Add(x, y)
+	if x.IsEmpty() || y.IsEmpty() {
+		return in, nil
+	}
+	if overflows(x.a, y.a) || overflows(x.b, y.b) {
+		return in, ErrOverflow
 	}
-	return &Interval{x.a + y.a, x.b + y.b, x.ends & y.ends}
+	return in, nil
+}
+
+// overflows reports whether the finite values x and y have an infinite sum.
+func overflows(x, y float64) bool {
+	return math.IsInf(x+y, 0) && !math.IsInf(x, 0) && !math.IsInf(y, 0)
 }
 
 // Sub returns the difference x-y.
+// A result whose endpoints round to the same value is repaired as in Add.
 //
 // Special case is:
 //	Sub(x, y) = empty if x or y is empty
 func Sub(x, y *Interval) *Interval {
-	if x.IsEmpty() || y.IsEmpty() {
-		return empty()
-	}
-	return Add(x, y.Neg())
+	in := add(*x, y.neg())
+	return &in
 }
 
 // Mul returns the product x*y.
@@ -61,39 +133,259 @@ func Sub(x, y *Interval) *Interval {
 // Special case is:
 //	Mul(x, y) = empty if x or y is empty
 func Mul(x, y *Interval) *Interval {
+	in := mul(*x, *y)
+	return &in
+}
+
+// mul returns the product x*y as a value.
+// Working on values rather than pointers lets the recursive calls
+// that normalize the signs of x and y avoid heap allocation.
+func mul(x, y Interval) Interval {
 	switch {
 	case x.IsEmpty() || y.IsEmpty():
-		return empty()
+		return Interval{}
 	case x.IsZero() || y.IsZero():
-		return zero()
+		return Interval{0, 0, Closed}
 	case x.isNeg():
-		return Mul(x.Neg(), y).Neg()
+		return mul(x.neg(), y).neg()
 	case y.isNeg():
-		return Mul(y.Neg(), x).Neg()
+		return mul(y.neg(), x).neg()
 	case x.isPos() && y.isPos():
 		e := x.ends & y.ends
 		if x.a == 0 && x.LeftIsClosed() || y.a == 0 && y.LeftIsClosed() {
 			e |= leftEndMask
 		}
-		return &Interval{x.a * y.a, x.b * y.b, e}
+		return Interval{x.a * y.a, x.b * y.b, e}
 	case x.isPos() && y.IsMixed():
 		if x.RightIsClosed() {
-			return &Interval{x.b * y.a, x.b * y.b, y.ends}
+			return Interval{x.b * y.a, x.b * y.b, y.ends}
 		} else {
-			return &Interval{x.b * y.a, x.b * y.b, Open}
+			return Interval{x.b * y.a, x.b * y.b, Open}
 		}
 	case x.IsMixed() && y.IsMixed():
-		// Return an interval from min(x.a*y.a, x.a*y.b, x.b*y.a, x.b*y.b)
-		// to max(x.a*y.a, x.a*y.b, x.b*y.a, x.b*y.b) with appropriate ends
-		return Union(
-			&Interval{x.a * y.b, x.b * y.b, x.ends&y.ends.flip()&leftEndMask + x.ends&y.ends&rightEndMask},
-			&Interval{x.b * y.a, x.a * y.a, x.ends.flip()&y.ends&leftEndMask + x.ends.flip()&y.ends.flip()&rightEndMask},
-		)
+		// The minimum is x.a*y.b or x.b*y.a, and the maximum is x.a*y.a or x.b*y.b.
+		// An endpoint is closed if any product attaining it
+		// is of two closed endpoints.
+		var e Ends
+		lo1, lo2 := x.a*y.b, x.b*y.a
+		lo := math.Min(lo1, lo2)
+		if lo1 == lo && x.LeftIsClosed() && y.RightIsClosed() || lo2 == lo && x.RightIsClosed() && y.LeftIsClosed() {
+			e |= leftEndMask
+		}
+		hi1, hi2 := x.a*y.a, x.b*y.b
+		hi := math.Max(hi1, hi2)
+		if hi1 == hi && x.LeftIsClosed() && y.LeftIsClosed() || hi2 == hi && x.RightIsClosed() && y.RightIsClosed() {
+			e |= rightEndMask
+		}
+		return Interval{lo, hi, e}
 	case y.isPos():
-		return Mul(y, x)
+		return mul(y, x)
 	default:
-		panic(fmt.Sprintf("unhandled case %v*%v", x, y))
+		panic("unhandled case " + x.String() + "*" + y.String())
+	}
+}
+
+// Mul3 returns the product x*y*z.
+// It evaluates the eight products of endpoints exactly
+// and rounds the least and greatest outward once,
+// so its result contains every exact product.
+// An endpoint product with a zero factor is zero,
+// even if another of its factors is infinite.
+//
+// Special case is:
+//	Mul3(x, y, z) = empty if x, y, or z is empty
+func Mul3(x, y, z *Interval) *Interval {
+	if x.IsEmpty() || y.IsEmpty() || z.IsEmpty() {
+		return empty()
+	}
+	lo, hi, e := productBounds(x, y, z)
+	in, _ := NewBig(lo, hi, e)
+	return in
+}
+
+// productBounds returns the least and greatest products of one endpoint
+// from each of the non-empty intervals ins, evaluated exactly,
+// and the Ends describing whether each is attained.
+// A product is attained if each factor is a closed endpoint,
+// or if it is zero because a factor is a closed zero endpoint.
+// A product with a zero factor is zero, even if another factor is infinite.
+func productBounds(ins ...*Interval) (lo, hi *big.Float, e Ends) {
+	var loClosed, hiClosed bool
+	// Each of the 2^len(ins) bits of i selects an endpoint of one interval.
+	for i := 0; i < 1<<len(ins); i++ {
+		v := new(big.Float).SetPrec(uint(53 * len(ins))).SetInt64(1)
+		attained, closed := false, true
+		for k, in := range ins {
+			x, c := in.a, in.LeftIsClosed()
+			if i>>k&1 != 0 {
+				x, c = in.b, in.RightIsClosed()
+			}
+			closed = closed && c
+			if x == 0 {
+				attained = attained || c
+				v.SetInt64(0)
+			} else if v.Sign() != 0 {
+				v.Mul(v, big.NewFloat(x))
+			}
+		}
+		attained = attained || closed
+		if lo == nil {
+			lo, hi, loClosed, hiClosed = v, v, attained, attained
+			continue
+		}
+		switch c := v.Cmp(lo); {
+		case c < 0:
+			lo, loClosed = v, attained
+		case c == 0:
+			loClosed = loClosed || attained
+		}
+		switch c := v.Cmp(hi); {
+		case c > 0:
+			hi, hiClosed = v, attained
+		case c == 0:
+			hiClosed = hiClosed || attained
+		}
+	}
+	return lo, hi, EndsFromBools(loClosed, hiClosed)
+}
+
+// Sum returns the sum of ins.
+// The endpoints are accumulated at higher precision and rounded outward once,
+// so unlike a fold of Add, the result contains the exact sum.
+// An endpoint of finite magnitude greater than math.MaxFloat64
+// makes the result unbounded on that side.
+//
+// Special cases are:
+//	Sum() = [0, 0]
+//	Sum(ins...) = empty if any of ins is empty
+func Sum(ins ...*Interval) *Interval {
+	lo := new(big.Float).SetPrec(128).SetMode(big.ToNegativeInf)
+	hi := new(big.Float).SetPrec(128).SetMode(big.ToPositiveInf)
+	e := Closed
+	for _, in := range ins {
+		if in.IsEmpty() {
+			return empty()
+		}
+		lo.Add(lo, big.NewFloat(in.a))
+		hi.Add(hi, big.NewFloat(in.b))
+		e &= in.ends
+	}
+	s, _ := NewBig(lo, hi, e)
+	return s
+}
+
+// Mean returns the arithmetic mean of ins,
+// the interval containing the mean of every selection of one value from each of ins.
+//
+// Special cases are:
+//	Mean(nil) = empty
+//	Mean(ins) = empty if any of ins is empty
+func Mean(ins []*Interval) *Interval {
+	if len(ins) == 0 {
+		return empty()
+	}
+	m, _ := Sum(ins...).DivScalar(float64(len(ins)))
+	return m
+}
+
+// Combine returns the weighted sum of ins, the interval containing
+// the sum of weights[i]*x[i] for every selection of one value x[i]
+// from each of ins. A negative weight swaps the corresponding interval's
+// endpoints along with their closure, and a zero weight contributes [0, 0]
+// even if the corresponding interval is unbounded.
+// The endpoints are accumulated at higher precision and rounded outward once.
+// Combine returns an empty interval and ErrLength if weights and ins
+// have different lengths, ErrNaN if a weight is NaN,
+// and ErrUnbounded if a weight is infinite.
+//
+// Special cases are:
+//	Combine(nil, nil) = [0, 0], nil
+//	Combine(weights, ins) = empty, nil if any of ins is empty
+func Combine(weights []float64, ins []*Interval) (*Interval, error) {
+	if len(weights) != len(ins) {
+		return empty(), ErrLength
+	}
+	for _, w := range weights {
+		switch {
+		case math.IsNaN(w):
+			return empty(), ErrNaN
+		case math.IsInf(w, 0):
+			return empty(), ErrUnbounded
+		}
+	}
+	lo := new(big.Float).SetPrec(128).SetMode(big.ToNegativeInf)
+	hi := new(big.Float).SetPrec(128).SetMode(big.ToPositiveInf)
+	e := Closed
+	for i, in := range ins {
+		w := weights[i]
+		switch {
+		case in.IsEmpty():
+			return empty(), nil
+		case w == 0:
+			continue
+		}
+		a, b, ends := in.a, in.b, in.ends
+		if w < 0 {
+			a, b, ends = b, a, ends.flip()
+		}
+		e &= ends
+		lo.Add(lo, affine(w, a, 0, big.ToNegativeInf))
+		hi.Add(hi, affine(w, b, 0, big.ToPositiveInf))
+	}
+	return NewBig(lo, hi, e)
+}
+
+// Dot returns the dot product of x and y, the interval containing
+// the sum of p[i]*q[i] for every selection of values p[i] from x[i]
+// and q[i] from y[i]. Each product's endpoints are computed exactly
+// and summed at higher precision with outward rounding,
+// so the result contains every exact dot product.
+// Dot returns an empty interval and ErrLength if x and y have different lengths.
+//
+// Special cases are:
+//	Dot(nil, nil) = [0, 0], nil
+//	Dot(x, y) = empty, nil if any element of x or y is empty
+func Dot(x, y []*Interval) (*Interval, error) {
+	if len(x) != len(y) {
+		return empty(), ErrLength
+	}
+	lo := new(big.Float).SetPrec(128).SetMode(big.ToNegativeInf)
+	hi := new(big.Float).SetPrec(128).SetMode(big.ToPositiveInf)
+	e := Closed
+	for i := range x {
+		if x[i].IsEmpty() || y[i].IsEmpty() {
+			return empty(), nil
+		}
+		a, b, ends := productBounds(x[i], y[i])
+		lo.Add(lo, a)
+		hi.Add(hi, b)
+		e &= ends
+	}
+	return NewBig(lo, hi, e)
+}
+
+// Product returns the product of ins.
+//
+// Special cases are:
+//	Product() = [1, 1]
+//	Product(ins...) = empty if any of ins is empty
+//	Product(ins...) = [0, 0] if any of ins is [0, 0] and none is empty
+func Product(ins ...*Interval) *Interval {
+	var hasZero bool
+	for _, in := range ins {
+		if in.IsEmpty() {
+			return empty()
+		}
+		hasZero = hasZero || in.IsZero()
 	}
+	if hasZero {
+		return zero()
+	}
+	p := &Interval{1, 1, Closed}
+	for _, in := range ins {
+		p = Mul(p, in)
+	}
+	return p
 }
 
 // Div returns the quotient x/y, defined as the interval containing all values z
@@ -111,39 +403,206 @@ func Mul(x, y *Interval) *Interval {
 //	Div(empty, y) = Div(x, empty) = empty, nil
 //	Div(x, [0, 0]) = empty, ErrDivByZero
 //	Div([0, 0], y) = [0, 0], nil
+//
+// A non-nil error is an *IntervalError that records x and y.
 func Div(x, y *Interval) (*Interval, error) {
+	in, err := div(*x, *y)
+	return &in, divError(*x, *y, err)
+}
+
+// divError returns err wrapped in an IntervalError recording the division x/y,
+// or nil if err is nil.
+func divError(x, y Interval, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &IntervalError{x.String() + " / " + y.String(), err}
+}
+
+// div returns the quotient x/y as a value, as mul does for products.
+func div(x, y Interval) (Interval, error) {
 	switch {
 	case x.IsEmpty() || y.IsEmpty():
-		return empty(), nil
+		return Interval{}, nil
 	case y.IsZero():
-		return empty(), ErrDivByZero
+		return Interval{}, ErrDivByZero
 	case x.IsZero():
-		return zero(), nil
+		return Interval{0, 0, Closed}, nil
 	case x.isNeg():
-		in, err := Div(x.Neg(), y)
-		return in.Neg(), err
+		in, err := div(x.neg(), y)
+		return in.neg(), err
 	case y.isNeg():
-		in, err := Div(x, y.Neg())
-		return in.Neg(), err
+		in, err := div(x, y.neg())
+		return in.neg(), err
 
 	// Hickey et al.'s sign convention:
 	// When the left endpoint is zero, it is treated as +0 (reciprocal +inf),
 	// and a zero-valued right endpoint is treated as -0 (reciprocal -inf).
-	case x.has0() && y.IsMixed() || x.IsMixed() && y.has0():
-		return &Interval{neginf, inf, Open}, nil
+	case x.has0() && y.IsMixed() || x.IsMixed() && (y.has0() || y.a == 0):
+		return Interval{neginf, inf, Open}, nil
 	case x.isP1() && y.IsMixed():
 		// The quotient is the union of two disjoint intervals
 		// with endpoints -inf, x.a/y.a and x.a/y.b, +inf;
 		// return their enclosure.
-		return &Interval{neginf, inf, Open}, ErrDisjointUnion
-	case y.isP0():
-		return &Interval{x.a / y.b, inf, x.ends & y.ends.flip() & leftEndMask}, nil
-	// y is P1
+		return Interval{neginf, inf, Open}, ErrDisjointUnion
+	case y.a == 0:
+		// y is P0, or P1 with an open left endpoint at 0
+		e := x.ends & y.ends.flip() & leftEndMask
+		if x.isP0() {
+			e |= leftEndMask
+		}
+		return Interval{x.a / y.b, inf, e}, nil
+	// y is P1 with a positive left endpoint
 	case x.isPos():
-		return &Interval{x.a / y.b, x.b / y.a, x.ends & y.ends.flip()}, nil
+		e := x.ends & y.ends.flip()
+		if x.isP0() {
+			e |= leftEndMask
+		}
+		return Interval{x.a / y.b, x.b / y.a, e}, nil
 	case x.IsMixed():
-		return &Interval{x.a / y.a, x.b / y.a, x.ends&y.ends&leftEndMask + x.ends&y.ends.flip()&rightEndMask}, nil
+		return Interval{x.a / y.a, x.b / y.a, x.ends&y.ends&leftEndMask + x.ends&y.ends.flip()&rightEndMask}, nil
 	default:
-		panic(fmt.Sprintf("unhandled case %v/%v", x, y))
+		panic("unhandled case " + x.String() + "/" + y.String())
+	}
+}
+
+// DivDisjoint returns the quotient x/y as defined by Div, without
+// loss of information when the quotient is a union of disjoint intervals.
+// In that case, DivDisjoint returns the two intervals in increasing order
+// and disjoint is true. Otherwise, lo is the result of Div(x, y),
+// hi is nil, and disjoint is false.
+func DivDisjoint(x, y *Interval) (lo, hi *Interval, disjoint bool) {
+	in, err := Div(x, y)
+	if !errors.Is(err, ErrDisjointUnion) {
+		return in, nil, false
+	}
+	if x.isNeg() {
+		lo, hi, _ := DivDisjoint(x.Neg(), y)
+		return hi.Neg(), lo.Neg(), true
 	}
+	// x is P1 and y is mixed
+	lo = &Interval{neginf, x.a / y.a, (x.ends & y.ends & leftEndMask).flip()}
+	hi = &Interval{x.a / y.b, inf, x.ends & y.ends.flip() & leftEndMask}
+	return lo, hi, true
 }
+
+// DivFull returns the set of quotients p/q for p in x and q in y, as defined by Div.
+// The result has two elements, in increasing order, when the quotient is
+// a union of disjoint intervals, no elements when x or y is empty,
+// and one element otherwise.
+// DivFull returns a nil set and ErrDivByZero if y is [0, 0].
+func DivFull(x, y *Interval) (IntervalSet, error) {
+	in, err := Div(x, y)
+	switch {
+	case errors.Is(err, ErrDisjointUnion):
+		lo, hi, _ := DivDisjoint(x, y)
+		return IntervalSet{lo, hi}, nil
+	case err != nil:
+		return nil, err
+	case in.IsEmpty():
+		return nil, nil
+	}
+	return IntervalSet{in}, nil
+}
+
+// DivScalar returns the quotient in/k for finite nonzero k,
+// the interval Div(in, [k, k]) with its endpoints rounded outward
+// so that it contains the exact quotient of every value in in.
+//
+// Special cases are:
+//	DivScalar(empty, k) = empty, nil
+//	DivScalar(in, 0) = empty, ErrDivByZero
+//	DivScalar(in, ±Inf) = empty, ErrClosedInf
+//	DivScalar(in, NaN) = empty, ErrNaN
+//
+// A non-nil error is an *IntervalError that records in and k.
+func (in *Interval) DivScalar(k float64) (*Interval, error) {
+	var err error
+	switch {
+	case math.IsNaN(k):
+		err = ErrNaN
+	case math.IsInf(k, 0):
+		err = ErrClosedInf
+	case in.IsEmpty():
+		return empty(), nil
+	case k == 0:
+		err = ErrDivByZero
+	case k < 0:
+		return &Interval{divDown(in.b, k), divUp(in.a, k), in.ends.flip()}, nil
+	default:
+		return &Interval{divDown(in.a, k), divUp(in.b, k), in.ends}, nil
+	}
+	return empty(), &IntervalError{fmt.Sprintf("%v / %v", in, k), err}
+}
+
+// divDown returns x/k rounded toward negative infinity, for finite nonzero k.
+func divDown(x, k float64) float64 {
+	q := x / k
+	// The rounded quotient q exceeds x/k if q*k - x has the sign of k.
+	// A residual that underflows may lose its sign, so a tiny inexact
+	// quotient is stepped down unconditionally.
+	r := math.FMA(q, k, -x)
+	if r > 0 && k > 0 || r < 0 && k < 0 || x != 0 && math.Abs(q) < 0x1p-1022 {
+		q = math.Nextafter(q, neginf)
+	}
+	return q
+}
+
+// divUp returns x/k rounded toward positive infinity, for finite nonzero k.
+func divUp(x, k float64) float64 { return -divDown(-x, k) }
+
+// Affine returns the interval containing scale*x + offset for every x in in.
+// Each endpoint is computed exactly and rounded outward once,
+// so the result contains the exact value of scale*x + offset.
+// A negative scale swaps in's endpoints along with their closure.
+//
+// Special cases are:
+//
+//	Affine(empty, scale, offset) = empty
+//	Affine(in, 0, offset) = [offset, offset]
+//	Affine(in, scale, offset) = empty if scale or offset is NaN or ±Inf
+func (in *Interval) Affine(scale, offset float64) *Interval {
+	switch {
+	case in.IsEmpty() || math.IsNaN(scale) || math.IsNaN(offset) || math.IsInf(scale, 0) || math.IsInf(offset, 0):
+		return empty()
+	case scale == 0:
+		return &Interval{offset, offset, Closed}
+	}
+	a, b, ends := in.a, in.b, in.ends
+	if scale < 0 {
+		a, b, ends = b, a, ends.flip()
+	}
+	out, _ := NewBig(affine(scale, a, offset, big.ToNegativeInf), affine(scale, b, offset, big.ToPositiveInf), ends)
+	return out
+}
+
+// affine returns m*x + c, rounded in the given mode to a precision
+// that is exact for the product and at least as fine as float64 for the sum.
+func affine(m, x, c float64, mode big.RoundingMode) *big.Float {
+	p := new(big.Float).SetPrec(128).SetMode(mode).SetFloat64(m)
+	p.Mul(p, big.NewFloat(x))
+	return p.Add(p, big.NewFloat(c))
+}
+
+// twoSum returns s = a+b rounded to nearest and the rounding error e,
+// such that s+e = a+b exactly when s is finite.
+func twoSum(a, b float64) (s, e float64) {
+	s = a + b
+	bb := s - a
+	return s, (a - (s - bb)) + (b - bb)
+}
+
+// addDown returns a+b rounded toward negative infinity.
+func addDown(a, b float64) float64 {
+	s, e := twoSum(a, b)
+	switch {
+	case e < 0:
+		return math.Nextafter(s, neginf)
+	case math.IsInf(s, 1) && !math.IsInf(a, 0) && !math.IsInf(b, 0):
+		return math.MaxFloat64
+	}
+	return s
+}
+
+// addUp returns a+b rounded toward positive infinity.
+func addUp(a, b float64) float64 { return -addDown(-a, -b) }
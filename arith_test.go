@@ -1,6 +1,13 @@
 package interval
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
+	"slices"
+	"testing"
+)
 
 func TestNeg(t *testing.T) {
 	for _, test := range []struct{ in, want *Interval }{
@@ -24,6 +31,42 @@ func TestNeg(t *testing.T) {
 	}
 }
 
+func TestMulMixed(t *testing.T) {
+	for _, test := range []struct{ x, y, want *Interval }{
+		{&Interval{-2, 4, Closed}, &Interval{-3, 1, Closed}, &Interval{-12, 6, Closed}},
+		{&Interval{-2, 4, Open}, &Interval{-3, 1, Closed}, &Interval{-12, 6, Open}},
+		{&Interval{-2, 4, LeftClosed}, &Interval{-3, 1, Closed}, &Interval{-12, 6, RightClosed}},
+		{&Interval{-2, 2, LeftClosed}, &Interval{-2, 2, LeftClosed}, &Interval{-4, 4, RightClosed}},
+		{&Interval{-2, 2, LeftClosed}, &Interval{-2, 2, RightClosed}, &Interval{-4, 4, LeftClosed}},
+		{&Interval{-2, 2, RightClosed}, &Interval{-2, 2, LeftClosed}, &Interval{-4, 4, LeftClosed}},
+		{&Interval{-2, 2, Open}, &Interval{-2, 2, Closed}, &Interval{-4, 4, Open}},
+		{&Interval{-1, 1, Closed}, &Interval{-1, 1, Open}, &Interval{-1, 1, Open}},
+		{&Interval{neginf, 1, RightClosed}, &Interval{-1, inf, LeftClosed}, &Interval{neginf, inf, Open}},
+		{&Interval{neginf, 1, RightClosed}, &Interval{-1, 2, Closed}, &Interval{neginf, inf, Open}},
+	} {
+		if got := Mul(test.x, test.y); !Equal(got, test.want) {
+			t.Errorf("Mul(%v, %v): got %v, want %v", test.x, test.y, got, test.want)
+		}
+		if got := Mul(test.y, test.x); !Equal(got, test.want) {
+			t.Errorf("Mul(%v, %v): got %v, want %v", test.y, test.x, got, test.want)
+		}
+	}
+}
+
+func TestNegEmpty(t *testing.T) {
+	for _, in := range []*Interval{
+		{},
+		{0, 0, Open},
+		{1, 1, LeftClosed},
+		{1, -1, Closed},
+		{inf, inf, Open},
+	} {
+		if got := in.Neg(); *got != (Interval{}) {
+			t.Errorf("%v.Neg(): got %#v, want %#v", in, *got, Interval{})
+		}
+	}
+}
+
 var (
 	ine  = empty()
 	inz  = zero()
@@ -180,6 +223,41 @@ var arithTests = []struct {
 		inni,
 		&Interval{neginf, 0, Open}, nil,
 	},
+	{
+		inp1, &Interval{0, 2, RightClosed},
+		&Interval{1, 4, RightClosed},
+		&Interval{-1, 2, LeftClosed},
+		&Interval{0, 4, RightClosed},
+		&Interval{0.5, inf, LeftClosed}, nil,
+	},
+	{
+		inm, &Interval{0, 2, RightClosed},
+		&Interval{-2, 6, RightClosed},
+		&Interval{-4, 4, LeftClosed},
+		&Interval{-4, 8, Closed},
+		inr, nil,
+	},
+	{
+		inp1, &Interval{-2, 0, LeftClosed},
+		&Interval{-1, 2, LeftClosed},
+		&Interval{1, 4, RightClosed},
+		&Interval{-4, 0, LeftClosed},
+		&Interval{neginf, -0.5, RightClosed}, nil,
+	},
+	{
+		inp0, &Interval{1, 2, Open},
+		&Interval{1, 2.5, Open},
+		&Interval{-2, -0.5, Open},
+		&Interval{0, 1, LeftClosed},
+		&Interval{0, 0.5, LeftClosed}, nil,
+	},
+	{
+		inp0, &Interval{0, 2, Open},
+		&Interval{0, 2.5, Open},
+		&Interval{-2, 0.5, Open},
+		&Interval{0, 1, LeftClosed},
+		&Interval{0, inf, LeftClosed}, nil,
+	},
 }
 
 func TestAdd(t *testing.T) {
@@ -198,6 +276,54 @@ func TestSub(t *testing.T) {
 	}
 }
 
+func TestAddSubRoundingCollapse(t *testing.T) {
+	// The exact results are proper intervals with an open end
+	// whose endpoints round to the same value.
+	for _, test := range []struct {
+		name       string
+		f          func(x, y *Interval) *Interval
+		x, y, want *Interval
+	}{
+		{"Sub", Sub, &Interval{1, 1, Closed}, &Interval{0, 1e-20, RightClosed}, &Interval{1, 1, Closed}},
+		{"Sub", Sub, &Interval{0, 1e-20, LeftClosed}, &Interval{1, 1, Closed}, &Interval{-1, -1, Closed}},
+		{"Sub", Sub, &Interval{1e300, 1e300, Closed}, &Interval{-1, 1, Open}, &Interval{1e300, 1e300, Closed}},
+		{"Add", Add, &Interval{1, 1, Closed}, &Interval{0, 1e-20, Open}, &Interval{1, 1, Closed}},
+	} {
+		if got := test.f(test.x, test.y); !Equal(got, test.want) {
+			t.Errorf("%s(%v, %v): got %v, want %v", test.name, test.x, test.y, got, test.want)
+		}
+	}
+}
+
+func TestAddOverflow(t *testing.T) {
+	const huge = math.MaxFloat64
+	for _, test := range []struct {
+		x, y, want *Interval
+		err        error
+	}{
+		// The right endpoint overflows, and the sum becomes unbounded above.
+		{&Interval{1, huge, Closed}, &Interval{1, huge, Closed}, &Interval{2, inf, LeftClosed}, ErrOverflow},
+		{&Interval{-huge, -1, Closed}, &Interval{-huge, -1, Open}, &Interval{neginf, -2, Open}, ErrOverflow},
+		// Both endpoints overflow; the sum is not empty.
+		{&Interval{huge, huge, Closed}, &Interval{huge, huge, Closed}, &Interval{huge, inf, Open}, ErrOverflow},
+		{&Interval{-huge, -huge, Closed}, &Interval{-huge, -huge, Closed}, &Interval{neginf, -huge, Open}, ErrOverflow},
+		// The sum is genuinely unbounded.
+		{&Interval{1, huge, Closed}, inpi, &Interval{2, inf, LeftClosed}, nil},
+		{inni, &Interval{-huge, 0, Closed}, &Interval{neginf, -1, RightClosed}, nil},
+		// Large endpoints that do not overflow.
+		{&Interval{huge / 2, huge / 2, Closed}, &Interval{huge / 2, huge / 2, Closed}, &Interval{huge, huge, Closed}, nil},
+		{&Interval{-huge, huge, Closed}, &Interval{-1, 1, Closed}, &Interval{-huge, huge, Closed}, nil},
+		{ine, &Interval{huge, huge, Closed}, ine, nil},
+	} {
+		if got := Add(test.x, test.y); !Identical(got, test.want) {
+			t.Errorf("Add(%v, %v): got %v, want %v", test.x, test.y, got, test.want)
+		}
+		if got, err := AddChecked(test.x, test.y); !Identical(got, test.want) || !errors.Is(err, test.err) {
+			t.Errorf("AddChecked(%v, %v): got %v, %v; want %v, %v", test.x, test.y, got, err, test.want, test.err)
+		}
+	}
+}
+
 func TestMul(t *testing.T) {
 	for _, test := range arithTests {
 		if got := Mul(test.x, test.y); !Equal(got, test.mul) {
@@ -206,10 +332,626 @@ func TestMul(t *testing.T) {
 	}
 }
 
+func TestMul3(t *testing.T) {
+	for _, test := range []struct{ x, y, z, want *Interval }{
+		{ine, inp1, inp1, ine},
+		{inp1, inp1, inp1, &Interval{1, 8, Closed}},
+		{inm, inp1, inn1, &Interval{-64, 32, Closed}},
+		{inz, inr, inr, inz},
+		{&Interval{0, 1, RightClosed}, &Interval{2, 3, Open}, inp1, &Interval{0, 6, Open}},
+		{&Interval{0, 1, Closed}, &Interval{2, 3, Open}, &Interval{4, 5, Open}, &Interval{0, 15, LeftClosed}},
+		{&Interval{-1, 1, Open}, &Interval{-1, 1, Open}, &Interval{-1, 1, Closed}, &Interval{-1, 1, Open}},
+		{inpi, inp0, inp1, &Interval{0, inf, LeftClosed}},
+		{inpi, inni, inp1, &Interval{neginf, -1, RightClosed}},
+		{&Interval{0.1, 0.1, Closed}, &Interval{0.1, 0.1, Closed}, &Interval{0.1, 0.1, Closed}, &Interval{0.001, 0.0010000000000000002, Closed}},
+	} {
+		if got := Mul3(test.x, test.y, test.z); !Equal(got, test.want) {
+			t.Errorf("Mul3(%v, %v, %v): got %v, want %v", test.x, test.y, test.z, got, test.want)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		x, y, z := RandomInterval(r), RandomInterval(r), RandomInterval(r)
+		got := Mul3(x, y, z)
+		lo, hi := got.BigFloats()
+		// The closure of the result contains each exact product of finite endpoints.
+		for _, p := range []float64{x.a, x.b} {
+			for _, q := range []float64{y.a, y.b} {
+				for _, s := range []float64{z.a, z.b} {
+					if math.IsInf(p, 0) || math.IsInf(q, 0) || math.IsInf(s, 0) {
+						continue
+					}
+					v := new(big.Float).SetPrec(3*53).Mul(big.NewFloat(p), big.NewFloat(q))
+					v.Mul(v, big.NewFloat(s))
+					if lo.Cmp(v) > 0 || hi.Cmp(v) < 0 {
+						t.Errorf("Mul3(%v, %v, %v): got %v, which does not enclose %v*%v*%v", x, y, z, got, p, q, s)
+					}
+				}
+			}
+		}
+		for k := 0; k < 10; k++ {
+			p, q, s := samples(r, x, 1)[0], samples(r, y, 1)[0], samples(r, z, 1)[0]
+			v := new(big.Float).SetPrec(3*53).Mul(big.NewFloat(p), big.NewFloat(q))
+			v.Mul(v, big.NewFloat(s))
+			if lo.Cmp(v) > 0 || hi.Cmp(v) < 0 {
+				t.Errorf("Mul3(%v, %v, %v): got %v, which does not enclose %v*%v*%v", x, y, z, got, p, q, s)
+			}
+		}
+	}
+}
+
+func TestSum(t *testing.T) {
+	for _, test := range []struct {
+		ins  []*Interval
+		want *Interval
+	}{
+		{nil, inz},
+		{[]*Interval{inm}, inm},
+		{[]*Interval{inp1, inm, inn1}, &Interval{-9, 2, Closed}},
+		{[]*Interval{inp0, &Interval{1, 2, Open}, inn0}, &Interval{0.75, 2.5, Open}},
+		{[]*Interval{inp1, inpi, inp0}, &Interval{2, inf, LeftClosed}},
+		{[]*Interval{inpi, inni}, inr},
+		{[]*Interval{inp1, ine, inm}, ine},
+		{[]*Interval{{0.1, 0.1, Closed}, {0.2, 0.2, Closed}}, &Interval{0.3, 0.30000000000000004, Closed}},
+		{[]*Interval{{math.MaxFloat64, math.MaxFloat64, Closed}, {math.MaxFloat64, math.MaxFloat64, Closed}}, &Interval{math.MaxFloat64, inf, LeftClosed}},
+	} {
+		if got := Sum(test.ins...); !Equal(got, test.want) {
+			t.Errorf("Sum(%v): got %v, want %v", test.ins, got, test.want)
+		}
+	}
+	// Every sum of values sampled from ins lies in the result.
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		ins := make([]*Interval, 1+r.Intn(5))
+		for j := range ins {
+			ins[j] = RandomInterval(r)
+		}
+		got := Sum(ins...)
+		lo, hi := got.BigFloats()
+		for k := 0; k < 10; k++ {
+			sum := new(big.Float).SetPrec(2200)
+			for _, in := range ins {
+				sum.Add(sum, big.NewFloat(samples(r, in, 1)[0]))
+			}
+			if lo.Cmp(sum) > 0 || hi.Cmp(sum) < 0 {
+				t.Errorf("Sum(%v): got %v, which does not enclose %v", ins, got, sum)
+			}
+		}
+	}
+}
+
+func TestMean(t *testing.T) {
+	for _, test := range []struct {
+		ins  []*Interval
+		want *Interval
+	}{
+		{nil, ine},
+		{[]*Interval{inm}, inm},
+		{[]*Interval{{0, 2, Closed}, {2, 4, Closed}}, &Interval{1, 3, Closed}},
+		{[]*Interval{{0, 2, Closed}, {2, 4, Open}}, &Interval{1, 3, Open}},
+		{[]*Interval{inp1, inpi}, &Interval{1, inf, LeftClosed}},
+		{[]*Interval{inp1, ine}, ine},
+	} {
+		if got := Mean(test.ins); !Equal(got, test.want) {
+			t.Errorf("Mean(%v): got %v, want %v", test.ins, got, test.want)
+		}
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		ins := make([]*Interval, 1+r.Intn(5))
+		for j := range ins {
+			ins[j] = RandomInterval(r)
+		}
+		m := Mean(ins)
+		sum := new(big.Float).SetPrec(2200)
+		for _, in := range ins {
+			sum.Add(sum, big.NewFloat(samples(r, in, 1)[0]))
+		}
+		x := sum.Quo(sum, big.NewFloat(float64(len(ins))))
+		if lo, hi := m.BigFloats(); lo.Cmp(x) > 0 || hi.Cmp(x) < 0 {
+			t.Errorf("Mean(%v): got %v, which does not contain %v", ins, m, x)
+		}
+	}
+}
+
+func TestDot(t *testing.T) {
+	for _, test := range []struct {
+		x, y []*Interval
+		want *Interval
+		err  error
+	}{
+		{nil, nil, inz, nil},
+		{[]*Interval{inp1}, nil, ine, ErrLength},
+		{[]*Interval{inp1, ine}, []*Interval{inp1, inp1}, ine, nil},
+		{[]*Interval{inp1, inn1}, []*Interval{inm, inp0}, &Interval{-8, 8, Closed}, nil},
+		{[]*Interval{{1, 2, LeftClosed}, inp1}, []*Interval{{3, 4, Closed}, {0, 1, Open}}, &Interval{3, 10, Open}, nil},
+		{[]*Interval{inpi, inp1}, []*Interval{inz, inn1}, &Interval{-16, -4, Closed}, nil},
+		{[]*Interval{{0.1, 0.1, Closed}, {0.2, 0.2, Closed}}, []*Interval{inp1, inp1}, &Interval{0.3, 0.6000000000000001, Closed}, nil},
+		{[]*Interval{inpi, inni}, []*Interval{inp1, inp1}, inr, nil},
+	} {
+		if got, err := Dot(test.x, test.y); !Equal(got, test.want) || !errors.Is(err, test.err) {
+			t.Errorf("Dot(%v, %v): got %v, %v; want %v, %v", test.x, test.y, got, err, test.want, test.err)
+		}
+	}
+
+	// With one term, Dot agrees with Mul wherever Mul is exact.
+	ins := []*Interval{inz, inp0, inp1, inm, inn0, inn1, inpi, inni, inr}
+	for _, x := range ins {
+		for _, y := range ins {
+			if got, err := Dot([]*Interval{x}, []*Interval{y}); !Equal(got, Mul(x, y)) || err != nil {
+				t.Errorf("Dot([%v], [%v]): got %v, %v; want %v, <nil>", x, y, got, err, Mul(x, y))
+			}
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		x, y := make([]*Interval, 1+r.Intn(4)), make([]*Interval, 0, 4)
+		for j := range x {
+			x[j] = RandomInterval(r)
+			y = append(y, RandomInterval(r))
+		}
+		got, _ := Dot(x, y)
+		lo, hi := got.BigFloats()
+		for k := 0; k < 10; k++ {
+			sum := new(big.Float).SetPrec(2200)
+			for j := range x {
+				p, q := samples(r, x[j], 1)[0], samples(r, y[j], 1)[0]
+				sum.Add(sum, new(big.Float).SetPrec(2200).Mul(big.NewFloat(p), big.NewFloat(q)))
+			}
+			if lo.Cmp(sum) > 0 || hi.Cmp(sum) < 0 {
+				t.Errorf("Dot(%v, %v): got %v, which does not enclose %v", x, y, got, sum)
+			}
+		}
+	}
+}
+
+func TestCombine(t *testing.T) {
+	for _, test := range []struct {
+		weights []float64
+		ins     []*Interval
+		want    *Interval
+		err     error
+	}{
+		{nil, nil, inz, nil},
+		{[]float64{1}, nil, ine, ErrLength},
+		{[]float64{1, math.NaN()}, []*Interval{inp1, inp1}, ine, ErrNaN},
+		{[]float64{1, inf}, []*Interval{inp1, inp1}, ine, ErrUnbounded},
+		{[]float64{1, 1}, []*Interval{inp1, ine}, ine, nil},
+		{[]float64{2}, []*Interval{inm}, &Interval{-4, 8, Closed}, nil},
+		{[]float64{1, -1}, []*Interval{inp1, inm}, Sub(inp1, inm), nil},
+		{[]float64{0.5, 0.5}, []*Interval{inp1, inn1}, &Interval{-3.5, -1, Closed}, nil},
+		{[]float64{-2, 1}, []*Interval{{1, 2, LeftClosed}, inp1}, &Interval{-3, 0, RightClosed}, nil},
+		{[]float64{1, 0}, []*Interval{inp1, inr}, inp1, nil},
+		{[]float64{-1, 1}, []*Interval{inpi, inp1}, &Interval{neginf, 1, RightClosed}, nil},
+		{[]float64{1, 1}, []*Interval{inpi, inni}, inr, nil},
+	} {
+		if got, err := Combine(test.weights, test.ins); !Equal(got, test.want) || !errors.Is(err, test.err) {
+			t.Errorf("Combine(%v, %v): got %v, %v; want %v, %v", test.weights, test.ins, got, err, test.want, test.err)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		ins := make([]*Interval, 1+r.Intn(4))
+		ones := make([]float64, len(ins))
+		weights := make([]float64, len(ins))
+		for j := range ins {
+			ins[j] = randomIntInterval(r)
+			ones[j] = 1
+			weights[j] = r.NormFloat64()
+		}
+		// With unit weights and integer endpoints, Combine is exact.
+		if got, err := Combine(ones, ins); !Equal(got, Sum(ins...)) || err != nil {
+			t.Errorf("Combine(%v, %v): got %v, %v; want %v, <nil>", ones, ins, got, err, Sum(ins...))
+		}
+		got, _ := Combine(weights, ins)
+		lo, hi := got.BigFloats()
+		for k := 0; k < 10; k++ {
+			sum := new(big.Float).SetPrec(2200)
+			for j, in := range ins {
+				x := samples(r, in, 1)[0]
+				sum.Add(sum, new(big.Float).SetPrec(2200).Mul(big.NewFloat(weights[j]), big.NewFloat(x)))
+			}
+			if lo.Cmp(sum) > 0 || hi.Cmp(sum) < 0 {
+				t.Errorf("Combine(%v, %v): got %v, which does not enclose %v", weights, ins, got, sum)
+			}
+		}
+	}
+}
+
+func TestProduct(t *testing.T) {
+	for _, test := range []struct {
+		ins  []*Interval
+		want *Interval
+	}{
+		{nil, &Interval{1, 1, Closed}},
+		{[]*Interval{inm}, inm},
+		{[]*Interval{inp1, inm, inn1}, Mul(Mul(inp1, inm), inn1)},
+		{[]*Interval{inn0, inp0, inm, inn1}, Mul(Mul(Mul(inn0, inp0), inm), inn1)},
+		{[]*Interval{inpi, inni, &Interval{-1, 2, Open}}, Mul(Mul(inpi, inni), &Interval{-1, 2, Open})},
+		{[]*Interval{inp1, inz, inr}, inz},
+		{[]*Interval{inp1, inz, ine}, ine},
+		{[]*Interval{ine, inm}, ine},
+	} {
+		if got := Product(test.ins...); !Equal(got, test.want) {
+			t.Errorf("Product(%v): got %v, want %v", test.ins, got, test.want)
+		}
+	}
+}
+
 func TestDiv(t *testing.T) {
 	for _, test := range arithTests {
-		if got, err := Div(test.x, test.y); !Equal(got, test.div) || err != test.err {
+		if got, err := Div(test.x, test.y); !Equal(got, test.div) || !errors.Is(err, test.err) {
 			t.Errorf("Div(%v, %v): got %v, %v; want %v, %v", test.x, test.y, got, err, test.div, test.err)
 		}
 	}
 }
+
+func TestDivDisjoint(t *testing.T) {
+	for _, test := range arithTests {
+		if test.err == ErrDisjointUnion {
+			continue
+		}
+		if lo, hi, disjoint := DivDisjoint(test.x, test.y); !Equal(lo, test.div) || hi != nil || disjoint {
+			t.Errorf("DivDisjoint(%v, %v): got %v, %v, %v; want %v, <nil>, false", test.x, test.y, lo, hi, disjoint, test.div)
+		}
+	}
+	for _, test := range []struct{ x, y, lo, hi *Interval }{
+		{inp1, inm, &Interval{neginf, -0.5, RightClosed}, &Interval{0.25, inf, LeftClosed}},
+		{inn1, inm, &Interval{neginf, -1, RightClosed}, &Interval{2, inf, LeftClosed}},
+		{
+			&Interval{1, 2, RightClosed}, &Interval{-2, 4, Closed},
+			&Interval{neginf, -0.5, Open}, &Interval{0.25, inf, Open},
+		},
+		{
+			&Interval{1, 2, Closed}, &Interval{-2, 4, LeftClosed},
+			&Interval{neginf, -0.5, RightClosed}, &Interval{0.25, inf, Open},
+		},
+		{
+			&Interval{-2, -1, Closed}, &Interval{-2, 4, RightClosed},
+			&Interval{neginf, -0.25, RightClosed}, &Interval{0.5, inf, Open},
+		},
+		{inpi, inr, &Interval{neginf, 0, Open}, &Interval{0, inf, Open}},
+	} {
+		if lo, hi, disjoint := DivDisjoint(test.x, test.y); !Equal(lo, test.lo) || !Equal(hi, test.hi) || !disjoint {
+			t.Errorf("DivDisjoint(%v, %v): got %v, %v, %v; want %v, %v, true", test.x, test.y, lo, hi, disjoint, test.lo, test.hi)
+		}
+	}
+}
+
+func TestDivFull(t *testing.T) {
+	for _, test := range arithTests {
+		got, err := DivFull(test.x, test.y)
+		var want IntervalSet
+		switch {
+		case test.err == ErrDisjointUnion:
+			lo, hi, _ := DivDisjoint(test.x, test.y)
+			want = IntervalSet{lo, hi}
+		case test.err == nil && !test.div.IsEmpty():
+			want = IntervalSet{test.div}
+		}
+		wantErr := test.err
+		if wantErr == ErrDisjointUnion {
+			wantErr = nil
+		}
+		if !slices.EqualFunc(got, want, Equal) || !errors.Is(err, wantErr) {
+			t.Errorf("DivFull(%v, %v): got %v, %v; want %v, %v", test.x, test.y, got, err, want, wantErr)
+		}
+	}
+	for _, test := range []struct {
+		x, y *Interval
+		want IntervalSet
+	}{
+		{inp1, inm, IntervalSet{{neginf, -0.5, RightClosed}, {0.25, inf, LeftClosed}}},
+		{inn1, inm, IntervalSet{{neginf, -1, RightClosed}, {2, inf, LeftClosed}}},
+		{inpi, inr, IntervalSet{{neginf, 0, Open}, {0, inf, Open}}},
+	} {
+		if got, err := DivFull(test.x, test.y); !slices.EqualFunc(got, test.want, Equal) || err != nil {
+			t.Errorf("DivFull(%v, %v): got %v, %v; want %v, <nil>", test.x, test.y, got, err, test.want)
+		}
+	}
+}
+
+func TestDivScalar(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		k    float64
+		want *Interval
+		err  error
+	}{
+		{ine, 2, ine, nil},
+		{ine, 0, ine, nil},
+		{inp1, 0, ine, ErrDivByZero},
+		{inz, 0, ine, ErrDivByZero},
+		{inp1, math.NaN(), ine, ErrNaN},
+		{inp1, inf, ine, ErrClosedInf},
+		{inp1, neginf, ine, ErrClosedInf},
+		{inz, 3, inz, nil},
+		{inp1, 2, &Interval{0.5, 1, Closed}, nil},
+		{inp1, -2, &Interval{-1, -0.5, Closed}, nil},
+		{inm, 4, &Interval{-0.5, 1, Closed}, nil},
+		{inm, -4, &Interval{-1, 0.5, Closed}, nil},
+		{&Interval{1, 2, LeftClosed}, -1, &Interval{-2, -1, RightClosed}, nil},
+		{inpi, 2, &Interval{0.5, inf, LeftClosed}, nil},
+		{inpi, -2, &Interval{neginf, -0.5, RightClosed}, nil},
+		{inr, -3, inr, nil},
+		{&Interval{1, 1, Closed}, 3, &Interval{1.0 / 3, math.Nextafter(1.0/3, inf), Closed}, nil},
+		{&Interval{1, 2, Open}, -3, &Interval{math.Nextafter(-2.0/3, neginf), -1.0 / 3, Open}, nil},
+	} {
+		if got, err := test.in.DivScalar(test.k); !Equal(got, test.want) || !errors.Is(err, test.err) {
+			t.Errorf("%v.DivScalar(%v): got %v, %v; want %v, %v", test.in, test.k, got, err, test.want, test.err)
+		}
+		if test.err != nil || test.in.IsEmpty() {
+			continue
+		}
+		if got, _ := test.in.DivScalar(test.k); !Includes(got, mustDiv(test.in, test.k)) {
+			t.Errorf("%v.DivScalar(%v): got %v, want a superset of Div result %v", test.in, test.k, got, mustDiv(test.in, test.k))
+		}
+	}
+
+	// Every quotient of a value sampled from in lies in the result.
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		in := RandomInterval(r)
+		k := r.NormFloat64() * math.Pow(2, float64(r.Intn(40)-20))
+		got, err := in.DivScalar(k)
+		if err != nil {
+			t.Fatalf("%v.DivScalar(%v): got %v, %v", in, k, got, err)
+		}
+		lo, hi := got.BigFloats()
+		for _, x := range samples(r, in, 10) {
+			q := new(big.Float).SetPrec(2200).Quo(new(big.Float).SetPrec(2200).SetFloat64(x), big.NewFloat(k))
+			if lo.Cmp(q) > 0 || hi.Cmp(q) < 0 {
+				t.Errorf("%v.DivScalar(%v): got %v, which does not enclose %v/%v", in, k, got, x, k)
+			}
+		}
+	}
+}
+
+func TestAffine(t *testing.T) {
+	for _, test := range []struct {
+		in            *Interval
+		scale, offset float64
+		want          *Interval
+	}{
+		{ine, 2, 1, ine},
+		{inp1, math.NaN(), 1, ine},
+		{inp1, 2, inf, ine},
+		{inp1, 3, 1, &Interval{4, 7, Closed}},
+		{&Interval{1, 3, LeftClosed}, 0.5, 0, &Interval{0.5, 1.5, LeftClosed}},
+		{&Interval{1, 2, LeftClosed}, -2, 1, &Interval{-3, -1, RightClosed}},
+		{inm, -1, 0, &Interval{-4, 2, Closed}},
+		{inpi, -1, 0, &Interval{neginf, -1, RightClosed}},
+		{inpi, 2, -2, &Interval{0, inf, LeftClosed}},
+		{inr, 0, 5, &Interval{5, 5, Closed}},
+		{&Interval{math.MaxFloat64, math.MaxFloat64, Closed}, 2, 0, &Interval{math.MaxFloat64, inf, LeftClosed}},
+	} {
+		if got := test.in.Affine(test.scale, test.offset); !Equal(got, test.want) {
+			t.Errorf("%v.Affine(%v, %v): got %v, want %v", test.in, test.scale, test.offset, got, test.want)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		in := RandomInterval(r)
+		scale, offset := r.NormFloat64()*100, r.NormFloat64()*100
+		got := in.Affine(scale, offset)
+		// Scaling and shifting in two outward-rounded steps
+		// must give a result at least as wide.
+		if composed := in.Affine(scale, 0).Affine(1, offset); !Includes(composed, got) {
+			t.Errorf("%v.Affine(%v, %v) = %v: not included in two-step result %v", in, scale, offset, got, composed)
+		}
+		if in.IsEmpty() || math.IsInf(in.a, 0) || math.IsInf(in.b, 0) {
+			continue
+		}
+		lo, hi := got.BigFloats()
+		for _, x := range []float64{in.a, in.b} {
+			exact := new(big.Float).SetPrec(2200).SetFloat64(scale)
+			exact.Mul(exact, big.NewFloat(x))
+			exact.Add(exact, big.NewFloat(offset))
+			if lo.Cmp(exact) > 0 || hi.Cmp(exact) < 0 {
+				t.Errorf("%v.Affine(%v, %v) = %v: does not enclose %v*%v + %v", in, scale, offset, got, scale, x, offset)
+			}
+		}
+	}
+}
+
+func mustDiv(x *Interval, k float64) *Interval {
+	y, err := NewSingle(k)
+	if err != nil {
+		panic(err)
+	}
+	in, err := Div(x, y)
+	if err != nil {
+		panic(err)
+	}
+	return in
+}
+
+// The following laws hold exactly for the arithmetic functions,
+// since they are computed with default hardware rounding:
+// Add and Mul are commutative, Neg is an involution,
+// and Sub(x, x) contains 0.
+// Add is associative only up to rounding of the endpoint sums,
+// so TestAddAssociative uses intervals with integer endpoints.
+// Once outward rounding is implemented, these laws will hold
+// only up to enclosure: each side must contain the exact result,
+// but the two sides need not be equal.
+
+func TestAddCommutative(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		x, y := RandomInterval(r), RandomInterval(r)
+		if xy, yx := Add(x, y), Add(y, x); !Equal(xy, yx) {
+			t.Errorf("Add(%v, %v) = %v, Add(%v, %v) = %v", x, y, xy, y, x, yx)
+		}
+	}
+}
+
+func TestAddAssociative(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		x, y, z := randomIntInterval(r), randomIntInterval(r), randomIntInterval(r)
+		if lhs, rhs := Add(Add(x, y), z), Add(x, Add(y, z)); !Equal(lhs, rhs) {
+			t.Errorf("Add(Add(%v, %v), %v) = %v, Add(%v, Add(%v, %v)) = %v", x, y, z, lhs, x, y, z, rhs)
+		}
+	}
+}
+
+func TestMulCommutative(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		x, y := RandomInterval(r), RandomInterval(r)
+		if xy, yx := Mul(x, y), Mul(y, x); !Equal(xy, yx) {
+			t.Errorf("Mul(%v, %v) = %v, Mul(%v, %v) = %v", x, y, xy, y, x, yx)
+		}
+	}
+}
+
+func TestNegInvolution(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		x := RandomInterval(r)
+		if got := x.Neg().Neg(); !Equal(got, x) {
+			t.Errorf("%v.Neg().Neg(): got %v, want %v", x, got, x)
+		}
+	}
+}
+
+func TestSubSelfContainsZero(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		x := RandomInterval(r)
+		if got := Sub(x, x); !got.ContainsZero() {
+			t.Errorf("Sub(%v, %v): got %v, want an interval containing 0", x, x, got)
+		}
+	}
+}
+
+// randomIntInterval returns a random interval whose finite endpoints are integers.
+func randomIntInterval(r *rand.Rand) *Interval {
+	in := RandomInterval(r)
+	in.a, in.b = math.Floor(in.a), math.Ceil(in.b)
+	return in
+}
+
+// checkEncloses reports an error if op applied to values sampled from x and y
+// yields a value not contained in the interval result of op applied to x and y.
+// op is one of '+', '-', '*', and '/'.
+func checkEncloses(t *testing.T, r *rand.Rand, op byte, x, y *Interval) {
+	t.Helper()
+	var f func(a, b float64) float64
+	var in *Interval
+	switch op {
+	case '+':
+		f, in = func(a, b float64) float64 { return a + b }, Add(x, y)
+	case '-':
+		f, in = func(a, b float64) float64 { return a - b }, Sub(x, y)
+	case '*':
+		f, in = func(a, b float64) float64 { return a * b }, Mul(x, y)
+	case '/':
+		f = func(a, b float64) float64 { return a / b }
+		in, _ = Div(x, y)
+	}
+	for _, a := range samples(r, x, 20) {
+		for _, b := range samples(r, y, 20) {
+			if op == '/' && b == 0 {
+				continue
+			}
+			switch z := f(a, b); {
+			case math.IsInf(z, 1) && in.b == inf, math.IsInf(z, -1) && in.a == neginf:
+				// overflow toward an unbounded end
+			case !in.Contains(z):
+				t.Errorf("%v %c %v = %v, not in %v %c %v = %v", a, op, b, z, x, op, y, in)
+				return
+			}
+		}
+	}
+}
+
+// samples returns n values contained in the non-empty interval in,
+// including its closed endpoints.
+func samples(r *rand.Rand, in *Interval, n int) []float64 {
+	var xs []float64
+	if in.LeftIsClosed() {
+		xs = append(xs, in.a)
+	}
+	if in.RightIsClosed() {
+		xs = append(xs, in.b)
+	}
+	for len(xs) < n {
+		var x float64
+		switch {
+		case in.a == neginf && in.b == inf:
+			x = 1e3 * r.NormFloat64()
+		case in.a == neginf:
+			x = in.b - 1e3*r.ExpFloat64()
+		case in.b == inf:
+			x = in.a + 1e3*r.ExpFloat64()
+		default:
+			x = in.Lerp(r.Float64())
+		}
+		if in.Contains(x) {
+			xs = append(xs, x)
+		}
+	}
+	return xs
+}
+
+func TestEncloses(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		x, y := RandomInterval(r), RandomInterval(r)
+		for _, op := range []byte("+-*/") {
+			checkEncloses(t, r, op, x, y)
+		}
+	}
+}
+
+var benchResult *Interval
+
+func BenchmarkAdd(b *testing.B) {
+	x, y := &Interval{1, 2, Closed}, &Interval{-0.5, 0.25, LeftClosed}
+	b.ReportAllocs()
+	for b.Loop() {
+		benchResult = Add(x, y)
+	}
+}
+
+func BenchmarkMul(b *testing.B) {
+	for _, bm := range []struct {
+		name string
+		x, y *Interval
+	}{
+		{"pos", &Interval{1, 2, Closed}, &Interval{3, 4, LeftClosed}},
+		{"neg", &Interval{-2, -1, Closed}, &Interval{3, 4, LeftClosed}},
+		{"mixed", &Interval{-1, 2, Closed}, &Interval{-3, 4, LeftClosed}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				benchResult = Mul(bm.x, bm.y)
+			}
+		})
+	}
+}
+
+func BenchmarkDiv(b *testing.B) {
+	for _, bm := range []struct {
+		name string
+		x, y *Interval
+	}{
+		{"pos", &Interval{1, 2, Closed}, &Interval{3, 4, LeftClosed}},
+		{"neg", &Interval{-2, -1, Closed}, &Interval{3, 4, LeftClosed}},
+		{"mixed", &Interval{-1, 2, Closed}, &Interval{3, 4, LeftClosed}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				benchResult, _ = Div(bm.x, bm.y)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package interval
+
+import (
+	"math"
+	"math/big"
+)
+
+// BigFloats returns in's left and right endpoints as big.Floats.
+func (in *Interval) BigFloats() (*big.Float, *big.Float) {
+	return new(big.Float).SetFloat64(in.a), new(big.Float).SetFloat64(in.b)
+}
+
+// NewBig returns a pointer to the smallest interval with float64 endpoints
+// containing the interval with endpoints a and b.
+// a is rounded toward negative infinity and b toward positive infinity.
+// A finite endpoint that rounds to an infinity becomes open.
+// NewBig returns an empty interval and a non-nil error
+// under the same conditions as New.
+func NewBig(a, b *big.Float, ends Ends) (*Interval, error) {
+	x, acc := a.Float64()
+	if acc == big.Above {
+		x = math.Nextafter(x, neginf)
+	}
+	if x == neginf && !a.IsInf() {
+		ends &^= leftEndMask
+	}
+	y, acc := b.Float64()
+	if acc == big.Below {
+		y = math.Nextafter(y, inf)
+	}
+	if y == inf && !b.IsInf() {
+		ends &^= rightEndMask
+	}
+	return New(x, y, ends)
+}
@@ -0,0 +1,61 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigFloats(t *testing.T) {
+	for _, in := range []*Interval{
+		{0, 0, Closed},
+		{-2.5, 1.0 / 3, Open},
+		{neginf, 3, RightClosed},
+		{neginf, inf, Open},
+	} {
+		a, b := in.BigFloats()
+		if x, _ := a.Float64(); x != in.a {
+			t.Errorf("%v.BigFloats(): got left endpoint %v, want %v", in, a, in.a)
+		}
+		if y, _ := b.Float64(); y != in.b {
+			t.Errorf("%v.BigFloats(): got right endpoint %v, want %v", in, b, in.b)
+		}
+		if got, err := NewBig(a, b, in.ends); !Equal(got, in) || err != nil {
+			t.Errorf("NewBig(%v.BigFloats()): got %v, %v; want %v, <nil>", in, got, err, in)
+		}
+	}
+}
+
+func TestNewBig(t *testing.T) {
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+	negThird := new(big.Float).Neg(third)
+	huge := new(big.Float).Mul(big.NewFloat(math.MaxFloat64), big.NewFloat(2))
+	negHuge := new(big.Float).Neg(huge)
+	for _, test := range []struct {
+		a, b *big.Float
+		ends Ends
+		in   *Interval
+		err  error
+	}{
+		{big.NewFloat(1), big.NewFloat(2), Closed, &Interval{1, 2, Closed}, nil},
+		{big.NewFloat(2), big.NewFloat(1), Closed, empty(), ErrEmpty},
+		{third, third, Closed, &Interval{1.0 / 3, math.Nextafter(1.0/3, 1), Closed}, nil},
+		{negThird, negThird, Closed, &Interval{math.Nextafter(-1.0/3, -1), -1.0 / 3, Closed}, nil},
+		{negThird, third, Open, &Interval{math.Nextafter(-1.0/3, -1), math.Nextafter(1.0/3, 1), Open}, nil},
+		{big.NewFloat(0), huge, Closed, &Interval{0, inf, LeftClosed}, nil},
+		{negHuge, huge, Closed, &Interval{neginf, inf, Open}, nil},
+		{big.NewFloat(0), new(big.Float).SetInf(false), Closed, empty(), ErrClosedInf},
+	} {
+		got, err := NewBig(test.a, test.b, test.ends)
+		if !Equal(got, test.in) || !errors.Is(err, test.err) {
+			t.Errorf("NewBig(%v, %v, %v): got %v, %v; want %v, %v", test.a, test.b, test.ends, got, err, test.in, test.err)
+		}
+		if err != nil {
+			continue
+		}
+		if a, b := got.BigFloats(); a.Cmp(test.a) > 0 || b.Cmp(test.b) < 0 {
+			t.Errorf("NewBig(%v, %v, %v): %v does not enclose the arguments", test.a, test.b, test.ends, got)
+		}
+	}
+}
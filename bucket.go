@@ -0,0 +1,74 @@
+package interval
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrBucketCount is returned when Buckets is called
+// with a non-positive number of buckets.
+var ErrBucketCount = errors.New("non-positive bucket count")
+
+// Buckets divides in into n consecutive buckets of equal width,
+// up to rounding, that partition in. Each bucket contains its left endpoint
+// and not its right, except that the first bucket has in's left closure
+// and the last bucket has in's right closure.
+// Buckets returns a nil slice and a non-nil error if n is not positive
+// or if in is empty or unbounded.
+func Buckets(in *Interval, n int) ([]*Interval, error) {
+	if err := checkBuckets(in, n); err != nil {
+		return nil, err
+	}
+	bs := make([]*Interval, n)
+	for k := range bs {
+		bs[k] = &Interval{bucketEdge(in, n, k), bucketEdge(in, n, k+1), LeftClosed}
+	}
+	bs[0].ends = bs[0].ends&^leftEndMask | in.ends&leftEndMask
+	bs[n-1].ends = bs[n-1].ends&^rightEndMask | in.ends&rightEndMask
+	return bs, nil
+}
+
+// BucketIndex returns the index of the bucket returned by Buckets(in, n)
+// that contains x, and reports whether there is one.
+// It computes the index directly rather than by searching.
+// ok is false if x is not contained in in, or if Buckets(in, n)
+// would return an error.
+func BucketIndex(in *Interval, n int, x float64) (k int, ok bool) {
+	if checkBuckets(in, n) != nil || !in.Contains(x) {
+		return 0, false
+	}
+	if in.a == in.b {
+		return n - 1, true
+	}
+	d, w := x-in.a, in.b-in.a
+	if math.IsInf(w, 1) {
+		// Halving the endpoints keeps the ratio while avoiding overflow.
+		d, w = x/2-in.a/2, in.b/2-in.a/2
+	}
+	k = min(max(int(d/w*float64(n)), 0), n-1)
+	// Correct for rounding so that k agrees with the edges computed by Buckets.
+	for k > 0 && x < bucketEdge(in, n, k) {
+		k--
+	}
+	for k < n-1 && x >= bucketEdge(in, n, k+1) {
+		k++
+	}
+	return k, true
+}
+
+// checkBuckets returns the error, if any, that Buckets(in, n) would return.
+func checkBuckets(in *Interval, n int) error {
+	switch {
+	case n <= 0:
+		return ErrBucketCount
+	case in.IsEmpty():
+		return ErrEmpty
+	case math.IsInf(in.a, 0) || math.IsInf(in.b, 0):
+		return ErrUnbounded
+	}
+	return nil
+}
+
+// bucketEdge returns the left endpoint of the kth of n buckets of in.
+// The 0th and nth edges are in's left and right endpoints.
+func bucketEdge(in *Interval, n, k int) float64 { return in.Lerp(float64(k) / float64(n)) }
@@ -0,0 +1,165 @@
+package interval
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBuckets(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		n    int
+		want []*Interval
+	}{
+		{&Interval{0, 4, Closed}, 1, []*Interval{{0, 4, Closed}}},
+		{&Interval{0, 4, Open}, 1, []*Interval{{0, 4, Open}}},
+		{&Interval{0, 4, Closed}, 4, []*Interval{
+			{0, 1, LeftClosed}, {1, 2, LeftClosed}, {2, 3, LeftClosed}, {3, 4, Closed},
+		}},
+		{&Interval{0, 4, Open}, 2, []*Interval{{0, 2, Open}, {2, 4, LeftClosed}}},
+		{&Interval{-1, 1, RightClosed}, 2, []*Interval{{-1, 0, Open}, {0, 1, Closed}}},
+		{&Interval{-1, 1, LeftClosed}, 2, []*Interval{{-1, 0, LeftClosed}, {0, 1, LeftClosed}}},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Closed}, 2, []*Interval{
+			{-math.MaxFloat64, 0, LeftClosed}, {0, math.MaxFloat64, Closed},
+		}},
+	} {
+		if got, err := Buckets(test.in, test.n); !slices.EqualFunc(got, test.want, Equal) || err != nil {
+			t.Errorf("Buckets(%v, %v): got %v, %v; want %v, <nil>", test.in, test.n, got, err, test.want)
+		}
+	}
+	for _, test := range []struct {
+		in  *Interval
+		n   int
+		err error
+	}{
+		{&Interval{0, 1, Closed}, 0, ErrBucketCount},
+		{&Interval{0, 1, Closed}, -1, ErrBucketCount},
+		{empty(), 2, ErrEmpty},
+		{&Interval{0, inf, LeftClosed}, 2, ErrUnbounded},
+		{&Interval{neginf, 0, Open}, 2, ErrUnbounded},
+	} {
+		if got, err := Buckets(test.in, test.n); got != nil || err != test.err {
+			t.Errorf("Buckets(%v, %v): got %v, %v; want [], %v", test.in, test.n, got, err, test.err)
+		}
+	}
+}
+
+func TestBucketsPartition(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		in *Interval
+		n  int
+	}{
+		{&Interval{0, 1, Closed}, 1},
+		{&Interval{0, 1, Closed}, 3},
+		{&Interval{0.1, 0.7, Open}, 7},
+		{&Interval{-1e10, 3, LeftClosed}, 10},
+		{&Interval{2, 2.5, RightClosed}, 100},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Open}, 1000},
+		{&Interval{-math.MaxFloat64, 1, LeftClosed}, 6},
+	} {
+		bs, err := Buckets(test.in, test.n)
+		if len(bs) != test.n || err != nil {
+			t.Errorf("Buckets(%v, %v): got %d buckets, %v; want %d, <nil>", test.in, test.n, len(bs), err, test.n)
+			continue
+		}
+		width := (test.in.b - test.in.a) / float64(test.n)
+		for k, b := range bs {
+			if k > 0 && bs[k-1].b != b.a {
+				t.Errorf("Buckets(%v, %v): bucket %v does not abut %v", test.in, test.n, b, bs[k-1])
+			}
+			if w := b.b - b.a; math.Abs(w-width) > 1e-9*width {
+				t.Errorf("Buckets(%v, %v): bucket %v has width %v, want %v", test.in, test.n, b, w, width)
+			}
+		}
+		for _, x := range append(samples(r, test.in, 100), test.in.a, test.in.b) {
+			var n int
+			for _, b := range bs {
+				if b.Contains(x) {
+					n++
+				}
+			}
+			if want := b2i(test.in.Contains(x)); n != want {
+				t.Errorf("Buckets(%v, %v): %v is in %d buckets, want %d", test.in, test.n, x, n, want)
+			}
+		}
+	}
+}
+
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func TestBucketIndex(t *testing.T) {
+	in := &Interval{0, 10, Closed}
+	for _, test := range []struct {
+		in *Interval
+		n  int
+		x  float64
+		k  int
+		ok bool
+	}{
+		{in, 5, 0, 0, true},
+		{in, 5, 1, 0, true},
+		{in, 5, 2, 1, true},
+		{in, 5, 3.9, 1, true},
+		{in, 5, 4, 2, true},
+		{in, 5, 8, 4, true},
+		{in, 5, 10, 4, true},
+		{in, 5, -1, 0, false},
+		{in, 5, 10.5, 0, false},
+		{in, 5, math.NaN(), 0, false},
+		{&Interval{0, 10, Open}, 5, 0, 0, false},
+		{&Interval{0, 10, Open}, 5, 10, 0, false},
+		{&Interval{0, 10, Open}, 5, 9.99, 4, true},
+		{in, 1, 7, 0, true},
+		{in, 0, 7, 0, false},
+		{&Interval{0, inf, LeftClosed}, 5, 7, 0, false},
+		{&Interval{3, 3, Closed}, 4, 3, 3, true},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Closed}, 4, -math.MaxFloat64, 0, true},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Closed}, 4, -1, 1, true},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Closed}, 4, 0, 2, true},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Closed}, 4, math.MaxFloat64 / 2, 3, true},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Closed}, 4, math.MaxFloat64, 3, true},
+	} {
+		if k, ok := BucketIndex(test.in, test.n, test.x); k != test.k || ok != test.ok {
+			t.Errorf("BucketIndex(%v, %v, %v): got %v, %v; want %v, %v", test.in, test.n, test.x, k, ok, test.k, test.ok)
+		}
+	}
+}
+
+func TestBucketIndexAgrees(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		in *Interval
+		n  int
+	}{
+		{&Interval{0, 1, Closed}, 3},
+		{&Interval{0.1, 0.7, Open}, 7},
+		{&Interval{-1e10, 3, LeftClosed}, 10},
+		{&Interval{2, 2.5, RightClosed}, 100},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Open}, 1000},
+		{&Interval{-math.MaxFloat64, 1, LeftClosed}, 6},
+	} {
+		bs, _ := Buckets(test.in, test.n)
+		xs := samples(r, test.in, 100)
+		for _, b := range bs {
+			xs = append(xs, b.a, b.b)
+		}
+		for _, x := range xs {
+			k, ok := BucketIndex(test.in, test.n, x)
+			if want := test.in.Contains(x); ok != want {
+				t.Errorf("BucketIndex(%v, %v, %v): got ok = %v, want %v", test.in, test.n, x, ok, want)
+				continue
+			}
+			if ok && !bs[k].Contains(x) {
+				t.Errorf("BucketIndex(%v, %v, %v): got %d, but bucket %v does not contain %v", test.in, test.n, x, k, bs[k], x)
+			}
+		}
+	}
+}
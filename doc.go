@@ -19,6 +19,10 @@ Set operations on intervals are defined when their intersection is non-empty.
 Operations on empty intervals are semantically undefined and yield an empty
 interval result.
 
+The arithmetic and set functions take and return *Interval. Methods such as
+Interval.Add and Interval.Union provide the same operations on Interval values,
+which avoid a heap allocation per result in loops that perform many operations.
+
 Default hardware rounding of floating-point operations involving
 interval endpoints may lead to imprecise and potentially incorrect
 representation of the values contained in the interval.
@@ -0,0 +1,409 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+// ErrAsymptote is returned when the result of a call to Tan
+// is unbounded because its argument contains a singularity
+// of the tangent function, π/2 + kπ for some integer k.
+var ErrAsymptote = errors.New("interval contains an asymptote")
+
+// Tan returns the interval containing the tangent of every value in in.
+//
+// If in contains a singularity π/2 + kπ, the tangent takes on arbitrarily
+// large positive and negative values, and Tan returns (-inf, +inf) and
+// ErrAsymptote to signal the loss of information in the return value.
+// Tan also reports ErrAsymptote if in is at least math.Pi wide.
+// Otherwise the endpoints are widened outward by saturatingUlps units
+// in the last place to allow for rounding error in math.Tan.
+//
+// Special case is:
+//
+//	Tan(empty) = empty, nil
+func Tan(in *Interval) (*Interval, error) {
+	if in.IsEmpty() {
+		return empty(), nil
+	}
+	if math.IsInf(in.a, 0) || math.IsInf(in.b, 0) {
+		return all(), ErrAsymptote
+	}
+	// Singularities are π apart, so in contains one if it is at least π wide.
+	// Otherwise it contains at most one, which it contains if and only if
+	// the cosine, which is zero only at the singularities, changes sign.
+	// A float64 is never a singularity, and the cosine of one is far enough
+	// from zero that math.Cos reports its sign correctly.
+	// The width in.b-in.a rounds below math.Pi only if it is less than π.
+	if in.b-in.a >= math.Pi || (math.Cos(in.a) > 0) != (math.Cos(in.b) > 0) {
+		return all(), ErrAsymptote
+	}
+	a, b := math.Tan(in.a), math.Tan(in.b)
+	for range saturatingUlps {
+		a, b = math.Nextafter(a, neginf), math.Nextafter(b, inf)
+	}
+	return &Interval{a, b, in.ends}, nil
+}
+
+// Hypot returns the interval containing sqrt(p*p + q*q)
+// for every p in x and q in y.
+// The endpoints are computed at higher precision and rounded outward.
+//
+// Special case is:
+//
+//	Hypot(x, y) = empty if x or y is empty
+func Hypot(x, y *Interval) *Interval {
+	if x.IsEmpty() || y.IsEmpty() {
+		return empty()
+	}
+	xlo, xlc := x.mig()
+	ylo, ylc := y.mig()
+	xhi, xhc := x.mag()
+	yhi, yhc := y.mag()
+	var e Ends
+	if xlc && ylc {
+		e |= leftEndMask
+	}
+	if xhc && yhc {
+		e |= rightEndMask
+	}
+	a, b := hypot(xlo, ylo, false), hypot(xhi, yhi, true)
+	return &Interval{a, b, e & closedEnds(a, b)}
+}
+
+// hypot returns sqrt(p*p + q*q) rounded up if up is true,
+// and otherwise rounded down.
+func hypot(p, q float64, up bool) float64 {
+	if math.IsInf(p, 0) || math.IsInf(q, 0) {
+		return inf
+	}
+	// The sum of squares of float64s is exact at this precision.
+	const prec = 4400
+	bp, bq := big.NewFloat(p), big.NewFloat(q)
+	s := new(big.Float).SetPrec(prec).Mul(bp, bp)
+	s.Add(s, new(big.Float).SetPrec(prec).Mul(bq, bq))
+	h, _ := new(big.Float).SetPrec(128).Sqrt(s).Float64()
+	bh := big.NewFloat(h)
+	switch c := new(big.Float).SetPrec(prec).Mul(bh, bh).Cmp(s); {
+	case up && c < 0:
+		h = math.Nextafter(h, inf)
+	case !up && c > 0:
+		h = math.Nextafter(h, neginf)
+	}
+	return h
+}
+
+// Sqrt returns the interval containing the square root of every
+// non-negative value in in. Negative values, at which the square root
+// is undefined, are ignored.
+//
+// Special case is:
+//
+//	Sqrt(in) = empty if in contains no non-negative value
+func Sqrt(in *Interval) *Interval {
+	x := Intersection(in, &Interval{0, inf, LeftClosed})
+	if x.IsEmpty() {
+		return empty()
+	}
+	a, b := math.Sqrt(x.a), math.Sqrt(x.b)
+	// math.Sqrt is correctly rounded, so an inexact result
+	// is one unit in the last place from the outward bound.
+	if math.FMA(a, a, -x.a) > 0 {
+		a = math.Nextafter(a, neginf)
+	}
+	if math.FMA(b, b, -x.b) < 0 {
+		b = math.Nextafter(b, inf)
+	}
+	return &Interval{a, b, x.ends}
+}
+
+// mig returns the least absolute value of any value in the non-empty interval in,
+// or its infimum if there is none, and reports whether it is attained.
+func (in *Interval) mig() (float64, bool) {
+	switch {
+	case in.ContainsZero():
+		return 0, true
+	case in.b <= 0:
+		return -in.b, in.RightIsClosed()
+	}
+	return in.a, in.LeftIsClosed()
+}
+
+// mag returns the greatest absolute value of any value in the non-empty interval in,
+// or its supremum if there is none, and reports whether it is attained.
+func (in *Interval) mag() (float64, bool) {
+	switch a, b := -in.a, in.b; {
+	case a > b:
+		return a, in.LeftIsClosed()
+	case a < b:
+		return b, in.RightIsClosed()
+	default:
+		return b, in.ends != Open
+	}
+}
+
+// Abs returns the interval containing |x| for every x in in.
+//
+// Special case is:
+//
+//	Abs(empty) = empty
+func Abs(in *Interval) *Interval {
+	if in.IsEmpty() {
+		return empty()
+	}
+	lo, lc := in.mig()
+	hi, hc := in.mag()
+	return &Interval{lo, hi, EndsFromBools(lc, hc)}
+}
+
+// AbsDiff returns the interval containing |p-q| for every p in x and q in y.
+// It is equivalent to Abs(Sub(x, y)).
+// The result's left endpoint is 0 if x and y overlap.
+func AbsDiff(x, y *Interval) *Interval { return Abs(Sub(x, y)) }
+
+// Floor returns the smallest interval containing the greatest integer
+// less than or equal to every value in in.
+// The result's finite endpoints are integers and are closed.
+//
+// Special case is:
+//
+//	Floor(empty) = empty
+func Floor(in *Interval) *Interval {
+	if in.IsEmpty() {
+		return empty()
+	}
+	a, b := math.Floor(in.a), math.Floor(in.b)
+	if b == in.b && !in.RightIsClosed() {
+		b--
+	}
+	return &Interval{a, b, closedEnds(a, b)}
+}
+
+// Ceil returns the smallest interval containing the least integer
+// greater than or equal to every value in in.
+// The result's finite endpoints are integers and are closed.
+//
+// Special case is:
+//
+//	Ceil(empty) = empty
+func Ceil(in *Interval) *Interval {
+	if in.IsEmpty() {
+		return empty()
+	}
+	a, b := math.Ceil(in.a), math.Ceil(in.b)
+	if a == in.a && !in.LeftIsClosed() {
+		a++
+	}
+	return &Interval{a, b, closedEnds(a, b)}
+}
+
+// Step returns the smallest interval containing the Heaviside step function
+// of every value in in. The step function is 0 for negative arguments
+// and 1 for non-negative arguments, including 0.
+// If in contains both negative and non-negative values, the result is [0, 1].
+//
+// Special case is:
+//
+//	Step(empty) = empty
+func Step(in *Interval) *Interval {
+	switch {
+	case in.IsEmpty():
+		return empty()
+	case in.a >= 0:
+		return &Interval{1, 1, Closed}
+	case in.b < 0 || in.b == 0 && !in.RightIsClosed():
+		return zero()
+	}
+	return &Interval{0, 1, Closed}
+}
+
+// ReLU returns the interval containing max(0, x) for every x in in.
+// Negative values are clamped to 0, which is then a closed left endpoint
+// of the result; otherwise in's endpoints and their closure are preserved.
+//
+// Special case is:
+//
+//	ReLU(empty) = empty
+func ReLU(in *Interval) *Interval {
+	switch {
+	case in.IsEmpty():
+		return empty()
+	case in.a >= 0:
+		return &Interval{in.a, in.b, in.ends}
+	case in.b < 0 || in.b == 0 && !in.RightIsClosed():
+		return zero()
+	}
+	return &Interval{0, in.b, in.ends | leftEndMask}
+}
+
+// Sigmoid returns the interval containing the logistic function
+// 1/(1+exp(-x)) of every value x in in.
+// The result is contained in [0, 1], and its endpoints are rounded outward.
+// The limits 0 and 1 are closed endpoints of the result if in is unbounded,
+// and open endpoints if they are reached only by rounding.
+//
+// Special case is:
+//
+//	Sigmoid(empty) = empty
+func Sigmoid(in *Interval) *Interval {
+	return saturating(in, func(x float64) float64 { return 1 / (1 + math.Exp(-x)) }, 0, 1)
+}
+
+// Tanh returns the interval containing the hyperbolic tangent of every value in in.
+// The result is contained in [-1, 1], and its endpoints are rounded outward.
+// The limits -1 and 1 are closed endpoints of the result if in is unbounded,
+// and open endpoints if they are reached only by rounding.
+//
+// Special case is:
+//
+//	Tanh(empty) = empty
+func Tanh(in *Interval) *Interval { return saturating(in, math.Tanh, -1, 1) }
+
+// saturatingUlps is the number of units in the last place by which saturating
+// widens each endpoint. It exceeds the error of the functions passed to it,
+// which may be more than one unit in the last place.
+const saturatingUlps = 4
+
+// saturating returns an interval containing the image of in under the
+// increasing function f, whose limits at -inf and +inf are lo and hi.
+// Each finite endpoint of in is mapped by f, widened outward by saturatingUlps
+// units in the last place, and clamped to the open interval (lo, hi).
+// An infinite endpoint of in maps to the closed limit.
+func saturating(in *Interval, f func(float64) float64, lo, hi float64) *Interval {
+	if in.IsEmpty() {
+		return empty()
+	}
+	a, b, e := lo, hi, in.ends
+	if in.a == neginf {
+		e |= leftEndMask
+	} else {
+		a = f(in.a)
+		for range saturatingUlps {
+			a = math.Nextafter(a, neginf)
+		}
+		if a <= lo {
+			a, e = lo, e&^leftEndMask
+		}
+	}
+	if in.b == inf {
+		e |= rightEndMask
+	} else {
+		b = f(in.b)
+		for range saturatingUlps {
+			b = math.Nextafter(b, inf)
+		}
+		if b >= hi {
+			b, e = hi, e&^rightEndMask
+		}
+	}
+	return &Interval{a, b, e}
+}
+
+// ContractMonotone narrows x and y under the constraint y = f(x),
+// where f is monotone on x with inverse finv, and increasing reports
+// whether f is increasing rather than decreasing.
+// It intersects y with the image of x under f,
+// and then x with the image of the narrowed y under finv.
+// Each image is widened by one unit in the last place at each end
+// to allow for rounding error in f and finv.
+// An endpoint at which f or finv returns NaN is treated as unbounded.
+// If the constraint cannot be satisfied, ContractMonotone returns
+// two empty intervals.
+func ContractMonotone(x, y *Interval, f, finv func(float64) float64, increasing bool) (*Interval, *Interval) {
+	y = Intersection(y, monotoneImage(x, f, increasing))
+	x = Intersection(x, monotoneImage(y, finv, increasing))
+	if x.IsEmpty() || y.IsEmpty() {
+		return empty(), empty()
+	}
+	return x, y
+}
+
+// monotoneImage returns an interval containing f(x) for every x in in,
+// where f is monotone on in, rounded outward by one unit in the last place.
+func monotoneImage(in *Interval, f func(float64) float64, increasing bool) *Interval {
+	if in.IsEmpty() {
+		return empty()
+	}
+	a, b, e := f(in.a), f(in.b), in.ends
+	if !increasing {
+		a, b, e = b, a, e.flip()
+	}
+	if math.IsNaN(a) {
+		a = neginf
+	}
+	if math.IsNaN(b) {
+		b = inf
+	}
+	a, b = math.Nextafter(a, neginf), math.Nextafter(b, inf)
+	if a == neginf {
+		e &^= leftEndMask
+	}
+	if b == inf {
+		e &^= rightEndMask
+	}
+	return &Interval{a, b, e}
+}
+
+// RangeEncloses evaluates f at n evenly spaced points of in and returns
+// the smallest interval containing the results, along with whether claim
+// includes that interval. It is intended for testing interval extensions
+// of f against sampled values: a false result shows that claim is not an
+// enclosure of f over in, while a true result is evidence that it is.
+// The points include in's endpoints, or their nearest neighbors inside in
+// if they are open. An infinite endpoint is replaced by the finite float64
+// of greatest magnitude with the same sign.
+// If n is 1, the only point is the midpoint of the endpoints so chosen.
+// RangeEncloses returns an empty interval and false if n is not positive,
+// or if f returns NaN or the same infinity at every point.
+// It returns an empty interval and true if in is empty.
+func RangeEncloses(in *Interval, f func(float64) float64, n int, claim *Interval) (*Interval, bool) {
+	switch {
+	case in.IsEmpty():
+		return empty(), true
+	case n <= 0:
+		return empty(), false
+	}
+	lo, hi := math.Max(in.a, -math.MaxFloat64), math.Min(in.b, math.MaxFloat64)
+	if lo == in.a && !in.LeftIsClosed() {
+		lo = math.Nextafter(lo, inf)
+	}
+	if hi == in.b && !in.RightIsClosed() {
+		hi = math.Nextafter(hi, neginf)
+	}
+	ys := make([]float64, n)
+	for k := range ys {
+		t := 0.5
+		if n > 1 {
+			t = float64(k) / float64(n-1)
+		}
+		ys[k] = f(min(max(lo*(1-t)+hi*t, lo), hi))
+	}
+	got, err := Enclose(ys...)
+	if err != nil {
+		return empty(), false
+	}
+	return got, Includes(claim, got)
+}
+
+// MeanValueForm returns the mean value form of f over x,
+//
+//	f([c, c]) + df(x)*(x - [c, c])
+//
+// where c is the midpoint of x and df is an interval extension of the derivative of f.
+// By the mean value theorem it encloses the range of f over x if f([c, c]) does.
+// For a narrow x it is often much tighter than f(x) itself,
+// which may suffer from the dependency problem, though for a wide x
+// it may be wider; the two enclosures may be intersected.
+// MeanValueForm returns f(x) if x is unbounded and an empty interval if x is empty.
+func MeanValueForm(f, df func(*Interval) *Interval, x *Interval) *Interval {
+	switch {
+	case x.IsEmpty():
+		return empty()
+	case x.IsLeftUnbounded() || x.IsRightUnbounded():
+		return f(x)
+	}
+	mid, _ := x.MidRad()
+	c := &Interval{mid, mid, Closed}
+	return Add(f(c), Mul(df(x), Sub(x, c)))
+}
@@ -0,0 +1,470 @@
+package interval
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestTan(t *testing.T) {
+	for _, test := range []struct {
+		in, want *Interval
+		err      error
+	}{
+		{empty(), empty(), nil},
+		{zero(), &Interval{saturatingDown(0), saturatingUp(0), Closed}, nil},
+		{&Interval{0, math.Pi / 4, Closed}, &Interval{saturatingDown(0), saturatingUp(math.Tan(math.Pi / 4)), Closed}, nil},
+		{&Interval{-math.Pi / 4, 0, LeftClosed}, &Interval{saturatingDown(math.Tan(-math.Pi / 4)), saturatingUp(0), LeftClosed}, nil},
+		{&Interval{3, 4, Open}, &Interval{saturatingDown(math.Tan(3)), saturatingUp(math.Tan(4)), Open}, nil},
+		{&Interval{1, math.Pi / 2, RightClosed}, &Interval{saturatingDown(math.Tan(1)), saturatingUp(math.Tan(math.Pi / 2)), RightClosed}, nil},
+		{&Interval{-math.Pi / 2, -1, Open}, &Interval{saturatingDown(math.Tan(-math.Pi / 2)), saturatingUp(math.Tan(-1)), Open}, nil},
+		{&Interval{math.Nextafter(math.Pi/2, 2), 2, Closed}, &Interval{saturatingDown(math.Tan(math.Nextafter(math.Pi/2, 2))), saturatingUp(math.Tan(2)), Closed}, nil},
+		{&Interval{math.Pi / 2, 2, Closed}, &Interval{neginf, inf, Open}, ErrAsymptote},
+		{&Interval{-math.Pi / 2, math.Pi / 2, Closed}, &Interval{neginf, inf, Open}, ErrAsymptote},
+		{&Interval{1, 2, Closed}, &Interval{neginf, inf, Open}, ErrAsymptote},
+		{&Interval{-2, -1, Closed}, &Interval{neginf, inf, Open}, ErrAsymptote},
+		{&Interval{0, 10, Closed}, &Interval{neginf, inf, Open}, ErrAsymptote},
+		{&Interval{0, inf, LeftClosed}, &Interval{neginf, inf, Open}, ErrAsymptote},
+	} {
+		if got, err := Tan(test.in); !Equal(got, test.want) || err != test.err {
+			t.Errorf("Tan(%v): got %v, %v; want %v, %v", test.in, got, err, test.want, test.err)
+		}
+	}
+	// The result contains the true value, computed to 40 significant digits.
+	for _, test := range []struct {
+		x    float64
+		want string
+	}{
+		{1, "1.557407724654902230506974807458360173087"},
+		{math.Pi / 4, "0.9999999999999999387676600426323430133964"},
+	} {
+		in := &Interval{test.x, test.x, Closed}
+		if got, _ := Tan(in); !containsDecimal(got, test.want) {
+			t.Errorf("Tan(%v): got %v, which does not contain %v", in, got, test.want)
+		}
+	}
+}
+
+func TestHypot(t *testing.T) {
+	for _, test := range []struct{ x, y, want *Interval }{
+		{empty(), &Interval{0, 1, Closed}, empty()},
+		{&Interval{3, 4, Closed}, &Interval{4, 5, Closed}, &Interval{5, math.Nextafter(math.Sqrt(41), inf), Closed}},
+		{&Interval{-4, -3, Closed}, &Interval{-5, 4, Closed}, &Interval{3, math.Nextafter(math.Sqrt(41), inf), Closed}},
+		{&Interval{-1, 2, Closed}, &Interval{-3, 1, Closed}, &Interval{0, math.Nextafter(math.Sqrt(13), inf), Closed}},
+		{&Interval{-1, 2, Open}, &Interval{-3, 1, Open}, &Interval{0, math.Nextafter(math.Sqrt(13), inf), LeftClosed}},
+		{&Interval{0, 3, RightClosed}, &Interval{0, 4, Closed}, &Interval{0, 5, RightClosed}},
+		{&Interval{3, 4, LeftClosed}, &Interval{-4, 4, Closed}, &Interval{3, math.Sqrt(32), LeftClosed}},
+		{&Interval{1, 1, Closed}, &Interval{1, 1, Closed}, &Interval{math.Nextafter(math.Sqrt2, 0), math.Sqrt2, Closed}},
+		{&Interval{-4, 4, Open}, &Interval{0, 0, Closed}, &Interval{0, 4, LeftClosed}},
+		{&Interval{-4, 4, RightClosed}, &Interval{0, 0, Closed}, &Interval{0, 4, Closed}},
+		{&Interval{1, inf, LeftClosed}, &Interval{0, 0, Closed}, &Interval{1, inf, LeftClosed}},
+		{&Interval{math.MaxFloat64, math.MaxFloat64, Closed}, &Interval{1, 1, Closed}, &Interval{math.MaxFloat64, inf, LeftClosed}},
+	} {
+		if got := Hypot(test.x, test.y); !Equal(got, test.want) {
+			t.Errorf("Hypot(%v, %v): got %v, want %v", test.x, test.y, got, test.want)
+		}
+	}
+
+	// The result contains the exact hypotenuse of values sampled from x and y.
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		x, y := RandomInterval(r), RandomInterval(r)
+		got := Hypot(x, y)
+		lo, hi := got.BigFloats()
+		for k := 0; k < 10; k++ {
+			p, q := big.NewFloat(samples(r, x, 1)[0]), big.NewFloat(samples(r, y, 1)[0])
+			if p.IsInf() || q.IsInf() {
+				continue
+			}
+			sq := new(big.Float).SetPrec(4400).Mul(p, p)
+			sq.Add(sq, new(big.Float).SetPrec(4400).Mul(q, q))
+			// Compare squares, which are exact, rather than square roots.
+			lo2 := new(big.Float).SetPrec(4400).Mul(lo, lo)
+			hi2 := new(big.Float).SetPrec(4400).Mul(hi, hi)
+			if lo2.Cmp(sq) > 0 || !hi.IsInf() && hi2.Cmp(sq) < 0 {
+				t.Errorf("Hypot(%v, %v): got %v, which does not enclose hypot(%v, %v)", x, y, got, p, q)
+			}
+		}
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	for _, test := range []struct{ in, want *Interval }{
+		{empty(), empty()},
+		{&Interval{4, 9, Closed}, &Interval{2, 3, Closed}},
+		{&Interval{2, 4, LeftClosed}, &Interval{math.Nextafter(math.Sqrt2, 0), 2, LeftClosed}},
+		{&Interval{1, 3, Open}, &Interval{1, math.Nextafter(math.Sqrt(3), inf), Open}},
+		{&Interval{-1, 4, RightClosed}, &Interval{0, 2, Closed}},
+		{&Interval{-1, 0, Closed}, &Interval{0, 0, Closed}},
+		{&Interval{-1, 0, LeftClosed}, empty()},
+		{&Interval{-3, -1, Closed}, empty()},
+		{&Interval{0, inf, Open}, &Interval{0, inf, Open}},
+		{&Interval{neginf, inf, Open}, &Interval{0, inf, LeftClosed}},
+	} {
+		if got := Sqrt(test.in); !Equal(got, test.want) {
+			t.Errorf("Sqrt(%v): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestAbs(t *testing.T) {
+	for _, test := range []struct{ in, want *Interval }{
+		{empty(), empty()},
+		{&Interval{0, 0, Closed}, &Interval{0, 0, Closed}},
+		{&Interval{2, 3, LeftClosed}, &Interval{2, 3, LeftClosed}},
+		{&Interval{-3, -2, LeftClosed}, &Interval{2, 3, RightClosed}},
+		{&Interval{-3, 2, Open}, &Interval{0, 3, LeftClosed}},
+		{&Interval{-2, 3, RightClosed}, &Interval{0, 3, Closed}},
+		{&Interval{-3, 3, LeftClosed}, &Interval{0, 3, Closed}},
+		{&Interval{-3, 3, Open}, &Interval{0, 3, LeftClosed}},
+		{&Interval{neginf, -1, RightClosed}, &Interval{1, inf, LeftClosed}},
+		{&Interval{neginf, inf, Open}, &Interval{0, inf, LeftClosed}},
+	} {
+		if got := Abs(test.in); !Equal(got, test.want) {
+			t.Errorf("Abs(%v): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestAbsDiff(t *testing.T) {
+	for _, test := range []struct{ x, y, want *Interval }{
+		{empty(), &Interval{0, 1, Closed}, empty()},
+		// disjoint
+		{&Interval{5, 7, Closed}, &Interval{1, 2, Closed}, &Interval{3, 6, Closed}},
+		{&Interval{1, 2, Closed}, &Interval{5, 7, Closed}, &Interval{3, 6, Closed}},
+		{&Interval{1, 2, Open}, &Interval{5, 7, Closed}, &Interval{3, 6, Open}},
+		// overlapping
+		{&Interval{1, 4, Closed}, &Interval{3, 5, Closed}, &Interval{0, 4, Closed}},
+		{&Interval{1, 4, Open}, &Interval{3, 5, Open}, &Interval{0, 4, LeftClosed}},
+		{&Interval{0, 1, Closed}, &Interval{0, 1, Closed}, &Interval{0, 1, Closed}},
+		{&Interval{0, inf, LeftClosed}, &Interval{2, 2, Closed}, &Interval{0, inf, LeftClosed}},
+	} {
+		got := AbsDiff(test.x, test.y)
+		if !Equal(got, test.want) {
+			t.Errorf("AbsDiff(%v, %v): got %v, want %v", test.x, test.y, got, test.want)
+		}
+		if want := Abs(Sub(test.x, test.y)); !Equal(got, want) {
+			t.Errorf("AbsDiff(%v, %v): got %v, want Abs(Sub(x, y)) = %v", test.x, test.y, got, want)
+		}
+	}
+}
+
+func TestFloorCeil(t *testing.T) {
+	for _, test := range []struct{ in, floor, ceil *Interval }{
+		{empty(), empty(), empty()},
+		{&Interval{1.2, 3.8, Closed}, &Interval{1, 3, Closed}, &Interval{2, 4, Closed}},
+		{&Interval{1.2, 3.8, Open}, &Interval{1, 3, Closed}, &Interval{2, 4, Closed}},
+		{&Interval{1, 2, Closed}, &Interval{1, 2, Closed}, &Interval{1, 2, Closed}},
+		{&Interval{1, 2, LeftClosed}, &Interval{1, 1, Closed}, &Interval{1, 2, Closed}},
+		{&Interval{1, 2, RightClosed}, &Interval{1, 2, Closed}, &Interval{2, 2, Closed}},
+		{&Interval{1, 2, Open}, &Interval{1, 1, Closed}, &Interval{2, 2, Closed}},
+		{&Interval{-1.5, -0.5, Closed}, &Interval{-2, -1, Closed}, &Interval{-1, 0, Closed}},
+		{&Interval{2.5, 2.5, Closed}, &Interval{2, 2, Closed}, &Interval{3, 3, Closed}},
+		{&Interval{neginf, 0.5, Open}, &Interval{neginf, 0, RightClosed}, &Interval{neginf, 1, RightClosed}},
+		{&Interval{0.5, inf, Open}, &Interval{0, inf, LeftClosed}, &Interval{1, inf, LeftClosed}},
+	} {
+		if got := Floor(test.in); !Equal(got, test.floor) {
+			t.Errorf("Floor(%v): got %v, want %v", test.in, got, test.floor)
+		}
+		if got := Ceil(test.in); !Equal(got, test.ceil) {
+			t.Errorf("Ceil(%v): got %v, want %v", test.in, got, test.ceil)
+		}
+	}
+}
+
+func TestStep(t *testing.T) {
+	for _, test := range []struct{ in, want *Interval }{
+		{empty(), empty()},
+		{&Interval{-3, -1, Closed}, &Interval{0, 0, Closed}},
+		{&Interval{-3, 0, LeftClosed}, &Interval{0, 0, Closed}},
+		{&Interval{-3, 0, Closed}, &Interval{0, 1, Closed}},
+		{&Interval{0, 0, Closed}, &Interval{1, 1, Closed}},
+		{&Interval{0, 2, Open}, &Interval{1, 1, Closed}},
+		{&Interval{1, 2, Closed}, &Interval{1, 1, Closed}},
+		{&Interval{-1, 1, Open}, &Interval{0, 1, Closed}},
+		{&Interval{neginf, -1, RightClosed}, &Interval{0, 0, Closed}},
+		{&Interval{neginf, inf, Open}, &Interval{0, 1, Closed}},
+	} {
+		if got := Step(test.in); !Equal(got, test.want) {
+			t.Errorf("Step(%v): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestReLU(t *testing.T) {
+	for _, test := range []struct{ in, want *Interval }{
+		{empty(), empty()},
+		{&Interval{1, 2, Open}, &Interval{1, 2, Open}},
+		{&Interval{0, 2, RightClosed}, &Interval{0, 2, RightClosed}},
+		{&Interval{-3, -1, Closed}, &Interval{0, 0, Closed}},
+		{&Interval{-3, 0, Open}, &Interval{0, 0, Closed}},
+		{&Interval{-3, 0, Closed}, &Interval{0, 0, Closed}},
+		{&Interval{-2, 3, Closed}, &Interval{0, 3, Closed}},
+		{&Interval{-2, 3, Open}, &Interval{0, 3, LeftClosed}},
+		{&Interval{neginf, 3, RightClosed}, &Interval{0, 3, Closed}},
+		{&Interval{neginf, inf, Open}, &Interval{0, inf, LeftClosed}},
+		{&Interval{neginf, -1, Open}, &Interval{0, 0, Closed}},
+	} {
+		if got := ReLU(test.in); !Equal(got, test.want) {
+			t.Errorf("ReLU(%v): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestSigmoid(t *testing.T) {
+	down, up := saturatingDown, saturatingUp
+	s1 := 1 / (1 + math.Exp(-1))
+	sm1 := 1 / (1 + math.E)
+	for _, test := range []struct{ in, want *Interval }{
+		{empty(), empty()},
+		{&Interval{0, 0, Closed}, &Interval{down(0.5), up(0.5), Closed}},
+		{&Interval{-800, -750, Open}, &Interval{0, up(0), Open}},
+		{&Interval{-800, -750, Closed}, &Interval{0, up(0), RightClosed}},
+		{&Interval{-1, 1, LeftClosed}, &Interval{down(sm1), up(s1), LeftClosed}},
+		{&Interval{40, 50, Closed}, &Interval{down(1), 1, LeftClosed}},
+		{&Interval{neginf, 0, RightClosed}, &Interval{0, up(0.5), Closed}},
+		{&Interval{0, inf, Open}, &Interval{down(0.5), 1, RightClosed}},
+		{&Interval{neginf, inf, Open}, &Interval{0, 1, Closed}},
+	} {
+		if got := Sigmoid(test.in); !Equal(got, test.want) {
+			t.Errorf("Sigmoid(%v): got %v, want %v", test.in, got, test.want)
+		}
+	}
+	// The result contains the true value, computed to 40 significant digits.
+	for _, test := range []struct {
+		x    float64
+		want string
+	}{
+		{-800, "3.667874584177687213455495654260798215469e-348"},
+		{-750, "1.901684963475006439995456236734016375233e-326"},
+		{-40, "4.248354255291588977280720904404506371434e-18"},
+		{-1, "0.2689414213699951207488407581781637256349"},
+		{0.5, "0.6224593312018545646389005657455084787532"},
+		{1, "0.7310585786300048792511592418218362743650"},
+		{20, "0.9999999979388463818097964185691378705252"},
+		{40, "0.9999999999999999957516457447084110227195"},
+	} {
+		in := &Interval{test.x, test.x, Closed}
+		if got := Sigmoid(in); !containsDecimal(got, test.want) {
+			t.Errorf("Sigmoid(%v): got %v, which does not contain %v", in, got, test.want)
+		}
+	}
+	r := rand.New(rand.NewSource(1))
+	unit := &Interval{0, 1, Closed}
+	for i := 0; i < 1000; i++ {
+		in := RandomInterval(r)
+		got := Sigmoid(in)
+		if !Includes(unit, got) {
+			t.Errorf("Sigmoid(%v): got %v, not in %v", in, got, unit)
+		}
+		// An open endpoint may coincide with the widened endpoint of a point.
+		for _, x := range samples(r, in, 10) {
+			if p := Sigmoid(&Interval{x, x, Closed}); !Includes(got.Closure(), p) {
+				t.Errorf("Sigmoid(%v): got %v, which does not include Sigmoid([%v, %v]) = %v", in, got, x, x, p)
+			}
+		}
+	}
+}
+
+// saturatingDown and saturatingUp return x moved saturatingUlps
+// units in the last place toward -inf and +inf.
+func saturatingDown(x float64) float64 {
+	for range saturatingUlps {
+		x = math.Nextafter(x, neginf)
+	}
+	return x
+}
+
+func saturatingUp(x float64) float64 { return -saturatingDown(-x) }
+
+// containsDecimal reports whether in contains the value of the decimal string s.
+func containsDecimal(in *Interval, s string) bool {
+	x, _, err := big.ParseFloat(s, 10, 256, big.ToNearestEven)
+	if err != nil {
+		panic(err)
+	}
+	lo, hi := in.BigFloats()
+	l, h := lo.Cmp(x), hi.Cmp(x)
+	return !in.IsEmpty() && (l < 0 || l == 0 && in.LeftIsClosed()) && (h > 0 || h == 0 && in.RightIsClosed())
+}
+
+func TestTanh(t *testing.T) {
+	for _, test := range []struct{ in, want *Interval }{
+		{empty(), empty()},
+		{&Interval{0, 0, Closed}, &Interval{saturatingDown(0), saturatingUp(0), Closed}},
+		{&Interval{-1, 1, Closed}, &Interval{saturatingDown(-math.Tanh(1)), saturatingUp(math.Tanh(1)), Closed}},
+		{&Interval{-2, 2, Open}, &Interval{saturatingDown(-math.Tanh(2)), saturatingUp(math.Tanh(2)), Open}},
+		{&Interval{0.1, 0.1, Closed}, &Interval{saturatingDown(math.Tanh(0.1)), saturatingUp(math.Tanh(0.1)), Closed}},
+		{&Interval{30, 40, Open}, &Interval{saturatingDown(1), 1, Open}},
+		{&Interval{neginf, 0, RightClosed}, &Interval{-1, saturatingUp(0), Closed}},
+		{&Interval{0, inf, Open}, &Interval{saturatingDown(0), 1, RightClosed}},
+		{&Interval{neginf, inf, Open}, &Interval{-1, 1, Closed}},
+	} {
+		if got := Tanh(test.in); !Equal(got, test.want) {
+			t.Errorf("Tanh(%v): got %v, want %v", test.in, got, test.want)
+		}
+	}
+	// The result contains the true value, computed to 40 significant digits.
+	for _, test := range []struct {
+		x    float64
+		want string
+	}{
+		{-35, "-0.9999999999999999999999999999992049100524"},
+		{0.1, "0.09966799462495582261427704369862483325555"},
+		{1, "0.7615941559557648881194582826047935904128"},
+		{2, "0.9640275800758168839464137241009231502550"},
+		{20, "0.9999999999999999915032914894168220454386"},
+		{30, "0.9999999999999999999999999824869784746070"},
+		{35, "0.9999999999999999999999999999992049100528"},
+		{40, "0.9999999999999999999999999999999999639030"},
+	} {
+		in := &Interval{test.x, test.x, Closed}
+		if got := Tanh(in); !containsDecimal(got, test.want) {
+			t.Errorf("Tanh(%v): got %v, which does not contain %v", in, got, test.want)
+		}
+	}
+	r := rand.New(rand.NewSource(1))
+	unit := &Interval{-1, 1, Closed}
+	for i := 0; i < 1000; i++ {
+		in := RandomInterval(r)
+		got := Tanh(in)
+		if !Includes(unit, got) {
+			t.Errorf("Tanh(%v): got %v, not in %v", in, got, unit)
+		}
+		// An open endpoint may coincide with the widened endpoint of a point.
+		for _, x := range samples(r, in, 10) {
+			if p := Tanh(&Interval{x, x, Closed}); !Includes(got.Closure(), p) {
+				t.Errorf("Tanh(%v): got %v, which does not include Tanh([%v, %v]) = %v", in, got, x, x, p)
+			}
+		}
+	}
+}
+
+func TestContractMonotone(t *testing.T) {
+	negExp := func(x float64) float64 { return math.Exp(-x) }
+	negLog := func(y float64) float64 { return -math.Log(y) }
+	for _, test := range []struct {
+		name         string
+		x, y         *Interval
+		f, finv      func(float64) float64
+		increasing   bool
+		xwant, ywant *Interval // narrowest acceptable results
+		xmax, ymax   *Interval // widest acceptable results
+	}{
+		{
+			"exp", &Interval{0, 10, Closed}, &Interval{1, math.Exp(2), Closed}, math.Exp, math.Log, true,
+			&Interval{0, 2, Closed}, &Interval{1, math.Exp(2), Closed},
+			&Interval{0, 2.000001, Closed}, &Interval{1, math.Exp(2), Closed},
+		},
+		{
+			"exp", &Interval{-1, 1, Closed}, &Interval{0, 100, Closed}, math.Exp, math.Log, true,
+			&Interval{-1, 1, Closed}, &Interval{1 / math.E, math.E, Closed},
+			&Interval{-1, 1, Closed}, &Interval{0.367, 2.719, Closed},
+		},
+		{
+			"exp", &Interval{neginf, inf, Open}, &Interval{neginf, 1, RightClosed}, math.Exp, math.Log, true,
+			&Interval{neginf, 0, RightClosed}, &Interval{0, 1, RightClosed},
+			&Interval{neginf, 1e-15, RightClosed}, &Interval{-1e-300, 1, Closed},
+		},
+		{
+			"exp(-x)", &Interval{-10, 10, Closed}, &Interval{1, math.Exp(2), Closed}, negExp, negLog, false,
+			&Interval{-2, 0, Closed}, &Interval{1, math.Exp(2), Closed},
+			&Interval{-2.000001, 1e-300, Closed}, &Interval{1, math.Exp(2), Closed},
+		},
+	} {
+		x, y := ContractMonotone(test.x, test.y, test.f, test.finv, test.increasing)
+		if !Includes(x, test.xwant) || !Includes(test.xmax, x) || !Includes(y, test.ywant) || !Includes(test.ymax, y) {
+			t.Errorf("ContractMonotone(%v, %v, %s): got %v, %v; want between %v, %v and %v, %v",
+				test.x, test.y, test.name, x, y, test.xwant, test.ywant, test.xmax, test.ymax)
+		}
+	}
+
+	x, y := ContractMonotone(&Interval{0, 1, Closed}, &Interval{10, 20, Closed}, math.Exp, math.Log, true)
+	if !x.IsEmpty() || !y.IsEmpty() {
+		t.Errorf("ContractMonotone(%v, %v, exp): got %v, %v; want %v, %v",
+			&Interval{0, 1, Closed}, &Interval{10, 20, Closed}, x, y, empty(), empty())
+	}
+}
+
+func TestRangeEncloses(t *testing.T) {
+	sigmoid := func(x float64) float64 { return 1 / (1 + math.Exp(-x)) }
+	hypot3 := func(x float64) float64 { return math.Hypot(x, 3) }
+	three := &Interval{3, 3, Closed}
+	for _, test := range []struct {
+		name string
+		f    func(float64) float64
+		in   *Interval
+		ext  func(*Interval) *Interval
+	}{
+		{"tanh", math.Tanh, &Interval{-2, 1, Closed}, Tanh},
+		{"tanh", math.Tanh, &Interval{0, inf, Open}, Tanh},
+		{"sigmoid", sigmoid, &Interval{-5, 5, Open}, Sigmoid},
+		{"sigmoid", sigmoid, &Interval{neginf, inf, Open}, Sigmoid},
+		{"abs", math.Abs, &Interval{-3, 2, LeftClosed}, Abs},
+		{"hypot3", hypot3, &Interval{-4, 1, Closed}, func(in *Interval) *Interval { return Hypot(in, three) }},
+		{"floor", math.Floor, &Interval{-1.5, 2.5, Open}, Floor},
+	} {
+		// Rounding may map a value near an open endpoint onto the endpoint.
+		claim := test.ext(test.in).Closure()
+		got, ok := RangeEncloses(test.in, test.f, 1000, claim)
+		if !ok {
+			t.Errorf("RangeEncloses(%v, %s, 1000, %v): got %v, false; want true", test.in, test.name, claim, got)
+		}
+		if got.IsEmpty() {
+			t.Errorf("RangeEncloses(%v, %s, 1000, %v): got empty sample range", test.in, test.name, claim)
+		}
+	}
+
+	// A claimed enclosure that is too narrow is detected.
+	in, claim := &Interval{0, 2, Closed}, &Interval{0, 1, Closed}
+	if got, ok := RangeEncloses(in, math.Exp2, 100, claim); ok || !Equal(got, &Interval{1, 4, Closed}) {
+		t.Errorf("RangeEncloses(%v, exp2, 100, %v): got %v, %v; want %v, false", in, claim, got, ok, &Interval{1, 4, Closed})
+	}
+	if got, ok := RangeEncloses(in, math.Exp2, 1, claim); ok || !Equal(got, &Interval{2, 2, Closed}) {
+		t.Errorf("RangeEncloses(%v, exp2, 1, %v): got %v, %v; want %v, false", in, claim, got, ok, &Interval{2, 2, Closed})
+	}
+	if got, ok := RangeEncloses(in, math.Exp2, 0, claim); ok || !got.IsEmpty() {
+		t.Errorf("RangeEncloses(%v, exp2, 0, %v): got %v, %v; want %v, false", in, claim, got, ok, empty())
+	}
+	if got, ok := RangeEncloses(in.Neg(), math.Sqrt, 10, all()); ok || !got.IsEmpty() {
+		t.Errorf("RangeEncloses(%v, sqrt, 10, %v): got %v, %v; want %v, false", in.Neg(), all(), got, ok, empty())
+	}
+}
+
+func TestMeanValueForm(t *testing.T) {
+	two := &Interval{2, 2, Closed}
+	// p(x) = x*x - 2x, evaluated naively, and its derivative 2x - 2.
+	p := func(in *Interval) *Interval { return Sub(Mul(in, in), Mul(two, in)) }
+	dp := func(in *Interval) *Interval { return Sub(Mul(two, in), two) }
+	pf := func(x float64) float64 { return x*x - 2*x }
+	for _, test := range []struct {
+		x *Interval
+		// ratio bounds the width of the mean value form
+		// relative to that of the naive evaluation.
+		ratio float64
+	}{
+		// Near the minimum at 1, the naive evaluation overestimates most.
+		{&Interval{0.9, 1.1, Closed}, 0.1},
+		{&Interval{0.99, 1.02, Open}, 0.1},
+		{&Interval{2.99, 3.01, Closed}, 0.6},
+	} {
+		x := test.x
+		naive, got := p(x), MeanValueForm(p, dp, x)
+		if !(got.Width() < test.ratio*naive.Width()) {
+			t.Errorf("MeanValueForm(p, dp, %v): got %v of width %v, want narrower than %v times the width of %v", x, got, got.Width(), test.ratio, naive)
+		}
+		if r, ok := RangeEncloses(x, pf, 1000, got); !ok {
+			t.Errorf("MeanValueForm(p, dp, %v): got %v, which does not enclose %v", x, got, r)
+		}
+	}
+	if got, want := MeanValueForm(p, dp, &Interval{0.9, 1.1, Closed}), (&Interval{-1.02, -0.98, Closed}); math.Abs(got.a-want.a) > 1e-15 || math.Abs(got.b-want.b) > 1e-15 {
+		t.Errorf("MeanValueForm(p, dp, [0.9, 1.1]): got %v, want %v", got, want)
+	}
+	if got := MeanValueForm(p, dp, ine); !got.IsEmpty() {
+		t.Errorf("MeanValueForm(p, dp, %v): got %v, want %v", ine, got, ine)
+	}
+	if got, want := MeanValueForm(p, dp, inpi), p(inpi); !Equal(got, want) {
+		t.Errorf("MeanValueForm(p, dp, %v): got %v, want %v", inpi, got, want)
+	}
+}
@@ -0,0 +1,64 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+)
+
+// FormatOptions controls the output of StringFunc.
+type FormatOptions struct {
+	// Unicode selects the symbols ∞, ≤, and ∅
+	// in place of inf, <=, and {}.
+	Unicode bool
+
+	// SetBuilder selects set-builder notation, such as {x : 0 ≤ x < 1},
+	// in place of interval notation, such as [0, 1).
+	SetBuilder bool
+}
+
+// StringFunc returns a string representation of in formatted according to opts.
+// The empty interval is formatted as {} or ∅ regardless of opts.SetBuilder,
+// and a degenerate interval in set-builder notation is formatted as {c}.
+func (in *Interval) StringFunc(opts FormatOptions) string {
+	if in.IsEmpty() {
+		if opts.Unicode {
+			return "∅"
+		}
+		return "{}"
+	}
+	a, b := opts.endpoint(in.a), opts.endpoint(in.b)
+	if !opts.SetBuilder {
+		l, r := in.brackets()
+		return fmt.Sprintf("%v%v, %v%v", l, a, b, r)
+	}
+	if in.IsSingle() {
+		return fmt.Sprintf("{%v}", a)
+	}
+	le, lt := "<=", "<"
+	if opts.Unicode {
+		le = "≤"
+	}
+	l, r := lt, lt
+	if in.LeftIsClosed() {
+		l = le
+	}
+	if in.RightIsClosed() {
+		r = le
+	}
+	return fmt.Sprintf("{x : %v %v x %v %v}", a, l, r, b)
+}
+
+// endpoint returns the string representation of the endpoint x.
+func (opts FormatOptions) endpoint(x float64) string {
+	inf := "inf"
+	if opts.Unicode {
+		inf = "∞"
+	}
+	switch {
+	case math.IsInf(x, 1):
+		return "+" + inf
+	case math.IsInf(x, -1):
+		return "-" + inf
+	}
+	return fmt.Sprint(x)
+}
@@ -0,0 +1,38 @@
+package interval
+
+import "testing"
+
+func TestStringFunc(t *testing.T) {
+	var (
+		ascii      = FormatOptions{}
+		unicode    = FormatOptions{Unicode: true}
+		setASCII   = FormatOptions{SetBuilder: true}
+		setUnicode = FormatOptions{Unicode: true, SetBuilder: true}
+	)
+	for _, test := range []struct {
+		in   *Interval
+		opts FormatOptions
+		want string
+	}{
+		{empty(), ascii, "{}"},
+		{empty(), unicode, "∅"},
+		{empty(), setASCII, "{}"},
+		{empty(), setUnicode, "∅"},
+		{&Interval{0, 1, LeftClosed}, ascii, "[0, 1)"},
+		{&Interval{0, 1, LeftClosed}, unicode, "[0, 1)"},
+		{&Interval{0, 1, LeftClosed}, setASCII, "{x : 0 <= x < 1}"},
+		{&Interval{0, 1, LeftClosed}, setUnicode, "{x : 0 ≤ x < 1}"},
+		{&Interval{-2.5, 4, RightClosed}, setUnicode, "{x : -2.5 < x ≤ 4}"},
+		{&Interval{3, 3, Closed}, ascii, "[3, 3]"},
+		{&Interval{3, 3, Closed}, setUnicode, "{3}"},
+		{&Interval{neginf, 3, RightClosed}, ascii, "(-inf, 3]"},
+		{&Interval{neginf, 3, RightClosed}, unicode, "(-∞, 3]"},
+		{&Interval{neginf, 3, RightClosed}, setASCII, "{x : -inf < x <= 3}"},
+		{&Interval{neginf, inf, Open}, unicode, "(-∞, +∞)"},
+		{&Interval{neginf, inf, Open}, setUnicode, "{x : -∞ < x < +∞}"},
+	} {
+		if got := test.in.StringFunc(test.opts); got != test.want {
+			t.Errorf("%v.StringFunc(%+v): got %q, want %q", test.in, test.opts, got, test.want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package interval
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"math"
@@ -17,8 +18,47 @@ var ErrEmpty = errors.New("empty interval")
 // that would create a closed left or right endpoint at -inf or +inf.
 var ErrClosedInf = errors.New("closed endpoint of infinite value")
 
+// ErrNegativeRadius is returned when NewMidRad is called with a negative radius.
+var ErrNegativeRadius = errors.New("negative radius")
+
+// An IntervalError records an error and the expression that caused it,
+// such as a call to New or a division.
+// Err is one of the package's sentinel errors,
+// so errors.Is(err, ErrDivByZero) reports whether err was caused
+// by division by the zero interval.
+// Callers may record the operands of other errors with Explain.
+type IntervalError struct {
+	Expr string // the offending expression, such as "[0, 0] / [0, 0]"
+	Err  error
+}
+
+func (e *IntervalError) Error() string { return e.Err.Error() + ": " + e.Expr }
+
+// Unwrap returns e.Err.
+func (e *IntervalError) Unwrap() error { return e.Err }
+
+// Explain returns err wrapped in an *IntervalError whose Expr is formatted
+// from format and args as by fmt.Sprintf, or nil if err is nil.
+// errors.Is reports whether the result wraps a sentinel error such as ErrDivByZero:
+//
+//	q, err := Div(x, y)
+//	err = Explain(err, "%v / %v", x, y)
+//	// err.Error() == "division by the zero interval: [1, 2] / [0, 0]"
+func Explain(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	return &IntervalError{fmt.Sprintf(format, args...), err}
+}
+
 // An Interval is a subset of the real numbers.
 // The Interval type's zero value corresponds to the empty interval (0, 0).
+//
+// Functions in this package assume that their arguments satisfy the
+// invariants enforced by New. An Interval that does not, such as one with
+// a NaN endpoint or a closed infinite endpoint, yields unspecified but
+// well-formed results: it never contains an infinity, and it is empty
+// if it has a NaN endpoint or two equal infinite endpoints.
 type Interval struct {
 	a, b float64
 	ends Ends
@@ -39,6 +79,45 @@ const (
 	rightEndMask Ends = 2
 )
 
+// EndsFromBools returns the Ends describing whether
+// an interval contains its left and right endpoints.
+func EndsFromBools(leftClosed, rightClosed bool) Ends {
+	var e Ends
+	if leftClosed {
+		e |= leftEndMask
+	}
+	if rightClosed {
+		e |= rightEndMask
+	}
+	return e
+}
+
+// Bools reports whether e describes a closed left and a closed right endpoint.
+func (e Ends) Bools() (leftClosed, rightClosed bool) {
+	return e&leftEndMask != 0, e&rightEndMask != 0
+}
+
+var endsNames = [...]string{"Open", "LeftClosed", "RightClosed", "Closed"}
+
+// String returns the name of the Ends constant that e describes.
+func (e Ends) String() string {
+	if e < 0 || int(e) >= len(endsNames) {
+		return fmt.Sprintf("Ends(%d)", int(e))
+	}
+	return endsNames[e]
+}
+
+// ParseEnds returns the Ends named by s, which must be
+// one of the strings returned by String for a valid Ends.
+func ParseEnds(s string) (Ends, error) {
+	for e, name := range endsNames {
+		if s == name {
+			return Ends(e), nil
+		}
+	}
+	return Open, fmt.Errorf("%w: %q", ErrSyntax, s)
+}
+
 var (
 	inf    = math.Inf(1)
 	neginf = math.Inf(-1)
@@ -47,55 +126,425 @@ var (
 // New returns a pointer to an Interval with endpoints x and y,
 // which may be positive or negative infinity.
 // Ends describes whether the endpoints are open or closed.
-// New returns an empty interval and a non-nil error
-// if x or y is NaN or if the interval is empty
-// or contains a closed endpoint of infinite value.
+// New returns an empty interval and an *IntervalError
+// wrapping ErrNaN if x or y is NaN, ErrEmpty if the interval is empty,
+// or ErrClosedInf if it contains a closed endpoint of infinite value.
+// A negative zero endpoint is stored as positive zero.
+// Functions in this package that distinguish the sign of a zero endpoint,
+// such as Div, do so by its position rather than its sign bit,
+// treating a left endpoint of 0 as +0 and a right endpoint of 0 as -0.
 func New(x, y float64, ends Ends) (*Interval, error) {
+	if err := Check(x, y, ends); err != nil {
+		return empty(), err
+	}
+	// Adding +0 maps -0 to +0 and leaves every other value unchanged.
+	return &Interval{x + 0, y + 0, ends}, nil
+}
+
+// Check returns the error that New would return for the same arguments,
+// without constructing an Interval.
+func Check(x, y float64, ends Ends) error {
+	if err := check(x, y, ends); err != nil {
+		return &IntervalError{fmt.Sprintf("New(%v, %v, %v)", x, y, ends), err}
+	}
+	return nil
+}
+
+// check returns the sentinel error that Check wraps.
+func check(x, y float64, ends Ends) error {
 	if math.IsNaN(x) || math.IsNaN(y) {
-		return empty(), ErrNaN
+		return ErrNaN
 	}
-	in := &Interval{x, y, ends}
-	if in.IsEmpty() {
-		return empty(), ErrEmpty
+	// Empty intervals are reported before closed infinite endpoints,
+	// so New(-inf, -inf, LeftClosed) returns ErrEmpty.
+	// This does not use IsEmpty, which also reports [+inf, +inf] as empty,
+	// so that NewSingle(±inf) returns ErrClosedInf.
+	if x > y || x == y && ends != Closed {
+		return ErrEmpty
 	}
+	in := Interval{x, y, ends}
 	if in.a == neginf && in.LeftIsClosed() || in.b == inf && in.RightIsClosed() {
-		return empty(), ErrClosedInf
+		return ErrClosedInf
 	}
-	return in, nil
+	return nil
 }
 
 // NewSingle is shorthand for New(x, x, Closed).
 func NewSingle(x float64) (*Interval, error) { return New(x, x, Closed) }
 
+// Gt returns the interval (x, +inf) of values greater than x.
+func Gt(x float64) (*Interval, error) { return New(x, inf, Open) }
+
+// Ge returns the interval [x, +inf) of values greater than or equal to x.
+func Ge(x float64) (*Interval, error) { return New(x, inf, LeftClosed) }
+
+// Lt returns the interval (-inf, x) of values less than x.
+func Lt(x float64) (*Interval, error) { return New(neginf, x, Open) }
+
+// Le returns the interval (-inf, x] of values less than or equal to x.
+func Le(x float64) (*Interval, error) { return New(neginf, x, RightClosed) }
+
+// NewSorted is like New, but accepts its endpoints in either order.
+// If x > y, the endpoints are swapped along with their closure,
+// so that NewSorted(4, 2, LeftClosed) returns (2, 4].
+func NewSorted(x, y float64, ends Ends) (*Interval, error) {
+	if x > y {
+		return New(y, x, ends.flip())
+	}
+	return New(x, y, ends)
+}
+
+// Enclose returns the smallest interval containing each of xs.
+// The result is closed except at an infinite endpoint, which is open.
+// Enclose returns an empty interval and a nil error if xs is empty,
+// and an empty interval and a non-nil error if any of xs is NaN
+// or if every value in xs is the same infinity.
+func Enclose(xs ...float64) (*Interval, error) {
+	if len(xs) == 0 {
+		return empty(), nil
+	}
+	lo, hi := xs[0], xs[0]
+	for _, x := range xs {
+		if math.IsNaN(x) {
+			return empty(), ErrNaN
+		}
+		lo, hi = math.Min(lo, x), math.Max(hi, x)
+	}
+	return New(lo, hi, closedEnds(lo, hi))
+}
+
+// NewMidRad returns a pointer to the smallest interval representable
+// by float64 endpoints that contains every value within rad of mid.
+// The result is closed except at an infinite endpoint, which is open.
+// NewMidRad returns an empty interval and a non-nil error
+// if mid or rad is NaN or if rad is negative.
+func NewMidRad(mid, rad float64) (*Interval, error) {
+	if math.IsNaN(mid) || math.IsNaN(rad) {
+		return empty(), ErrNaN
+	}
+	if rad < 0 {
+		return empty(), ErrNegativeRadius
+	}
+	lo, hi := addDown(mid, -rad), addUp(mid, rad)
+	return New(lo, hi, closedEnds(lo, hi))
+}
+
 // Left returns in's left endpoint.
 func (in *Interval) Left() float64 { return in.a }
 
 // Right returns in's right endpoint.
 func (in *Interval) Right() float64 { return in.b }
 
+// MidRad returns the midpoint of in and a radius such that
+// NewMidRad(in.MidRad()) contains in.
+// If in is unbounded, rad is +Inf and mid is 0, -Inf, or +Inf
+// according to which endpoints are infinite.
+// If in is empty, mid and rad are NaN.
+func (in *Interval) MidRad() (mid, rad float64) {
+	switch {
+	case in.IsEmpty():
+		return math.NaN(), math.NaN()
+	case in.a == neginf && in.b == inf:
+		return 0, inf
+	}
+	mid = in.a/2 + in.b/2
+	if math.IsInf(mid, 0) {
+		return mid, inf
+	}
+	return mid, math.Max(addUp(mid, -in.a), addUp(in.b, -mid))
+}
+
+// Width returns the length of in, rounded toward positive infinity.
+// Width returns +Inf if in is unbounded and 0 if in is empty.
+func (in *Interval) Width() float64 {
+	if in.IsEmpty() {
+		return 0
+	}
+	return addUp(in.b, -in.a)
+}
+
+// SignedWidth returns the difference of in's stored endpoints, b-a,
+// rounded to nearest. It is a low-level accessor: unlike Width,
+// it does not treat empty intervals specially, so it is negative
+// for an empty interval stored with its left endpoint greater than its right.
+func (in *Interval) SignedWidth() float64 { return in.b - in.a }
+
+// Bounds returns in's left and right endpoints.
+func (in *Interval) Bounds() (a, b float64) { return in.a, in.b }
+
+// EndpointUlps returns the unit in the last place of in's left and right
+// endpoints: the distance from each endpoint to the next float64
+// of greater magnitude. It returns +Inf for an infinite endpoint
+// and NaN, NaN if in is empty.
+func (in *Interval) EndpointUlps() (float64, float64) {
+	if in.IsEmpty() {
+		return math.NaN(), math.NaN()
+	}
+	return ulp(in.a), ulp(in.b)
+}
+
+// ulp returns the distance from x to the next float64 of greater magnitude,
+// or +Inf if x is infinite.
+func ulp(x float64) float64 {
+	x = math.Abs(x)
+	if math.IsInf(x, 1) {
+		return inf
+	}
+	if next := math.Nextafter(x, inf); !math.IsInf(next, 1) {
+		return next - x
+	}
+	// The spacing below MaxFloat64 is the same as the spacing above it would be.
+	return x - math.Nextafter(x, 0)
+}
+
+// HasSubnormalEndpoint reports whether either of in's endpoints is a subnormal
+// float64, a nonzero value of magnitude less than the smallest normal float64, 0x1p-1022.
+// Arithmetic on subnormal values carries fewer than 53 bits of precision,
+// so bounds computed from such an endpoint may be unreliable.
+// Zero is not subnormal. HasSubnormalEndpoint reports false if in is empty.
+func (in *Interval) HasSubnormalEndpoint() bool {
+	return !in.IsEmpty() && (isSubnormal(in.a) || isSubnormal(in.b))
+}
+
+// isSubnormal reports whether x is a nonzero subnormal float64.
+func isSubnormal(x float64) bool {
+	x = math.Abs(x)
+	return 0 < x && x < 0x1p-1022
+}
+
+// Lerp returns the linear interpolation a + t*(b-a) between in's left endpoint a
+// and right endpoint b, so that Lerp(0) = a and Lerp(1) = b.
+// Values of t outside [0, 1] extrapolate beyond the endpoints.
+// If in is unbounded, Lerp returns the limit of the interpolation:
+// an infinity whose sign is that of the infinite endpoint's coefficient,
+// t for b and 1-t for a, or NaN for (-inf, +inf) if 0 < t < 1,
+// where the infinities cancel.
+// If in is empty, Lerp returns NaN.
+func (in *Interval) Lerp(t float64) float64 {
+	switch {
+	case in.IsEmpty():
+		return math.NaN()
+	case t == 0:
+		return in.a
+	case t == 1:
+		return in.b
+	case math.IsInf(in.b-in.a, 0):
+		// in is unbounded, or its width overflows.
+		return (1-t)*in.a + t*in.b
+	}
+	return in.a + t*(in.b-in.a)
+}
+
+// MapRange returns the image of x under the affine map that takes
+// from's endpoints to to's endpoints, so that from's left and right
+// endpoints map to to's left and right endpoints respectively.
+// Values of x outside from map to values outside to.
+// If from is a single point, MapRange returns to's left endpoint.
+// If from is unbounded or either interval is empty, MapRange returns NaN.
+func MapRange(x float64, from, to *Interval) float64 {
+	switch {
+	case from.IsEmpty() || to.IsEmpty() || math.IsInf(from.a, 0) || math.IsInf(from.b, 0):
+		return math.NaN()
+	case from.a == from.b:
+		return to.a
+	}
+	return to.Lerp((x - from.a) / (from.b - from.a))
+}
+
 // Ends returns in's Ends.
 func (in *Interval) Ends() Ends { return in.ends }
 
+// A Span is a plain representation of an Interval's endpoints and their closure,
+// for use with encoders and other code that cannot access an Interval's fields.
+type Span struct {
+	Lo, Hi             float64
+	LoClosed, HiClosed bool
+}
+
+// Span returns in's endpoints and their closure as a Span.
+// If in is empty, Span returns the zero Span.
+func (in *Interval) Span() Span {
+	if in.IsEmpty() {
+		return Span{}
+	}
+	l, r := in.ends.Bools()
+	return Span{in.a, in.b, l, r}
+}
+
+// FromSpan returns the interval described by s.
+// FromSpan returns an empty interval and a non-nil error
+// under the same conditions as New.
+func FromSpan(s Span) (*Interval, error) {
+	return New(s.Lo, s.Hi, EndsFromBools(s.LoClosed, s.HiClosed))
+}
+
+// Included and Excluded name the inclusion of an endpoint in an interval,
+// for use with Bound.
+const (
+	Included = true
+	Excluded = false
+)
+
+// An Endpoint is a value bounding an interval, together with
+// whether the interval includes it.
+type Endpoint struct {
+	Value    float64
+	Included bool
+}
+
+// Bound returns the Endpoint with the given value and inclusion,
+// which is Included or Excluded.
+func Bound(value float64, inclusion bool) Endpoint { return Endpoint{value, inclusion} }
+
+// Between returns the interval with left endpoint lo and right endpoint hi,
+// such as Between(Bound(0, Included), Bound(1, Excluded)) for [0, 1).
+// Between returns an empty interval and a non-nil error
+// under the same conditions as New.
+func Between(lo, hi Endpoint) (*Interval, error) {
+	return New(lo.Value, hi.Value, EndsFromBools(lo.Included, hi.Included))
+}
+
 // IsEmpty reports whether in is an empty interval.
 // An interval with endpoints x and y is empty if x > y
 // or if x == y and either endpoint is open.
-func (in *Interval) IsEmpty() bool { return in.a > in.b || in.a == in.b && in.ends != Closed }
+// An Interval not created by this package whose endpoint is NaN,
+// or whose endpoints are the same infinity, is also empty.
+func (in *Interval) IsEmpty() bool { return !(in.a < in.b || in.IsSingle()) }
+
+// IsLeftUnbounded reports whether in is non-empty and its left endpoint is -inf.
+func (in *Interval) IsLeftUnbounded() bool { return in.a == neginf && !in.IsEmpty() }
+
+// IsRightUnbounded reports whether in is non-empty and its right endpoint is +inf.
+func (in *Interval) IsRightUnbounded() bool { return in.b == inf && !in.IsEmpty() }
 
 // IsMixed reports whether in contains at least one positive and one negative real number.
 func (in *Interval) IsMixed() bool { return in.a < 0 && 0 < in.b }
 
 // IsSingle reports whether in is a degenerate interval
 // representing a single real value.
-func (in *Interval) IsSingle() bool { return in.a == in.b && in.ends == Closed }
+func (in *Interval) IsSingle() bool {
+	return in.a == in.b && in.ends == Closed && !math.IsInf(in.a, 0)
+}
+
+// IsProper reports whether in contains more than one real number,
+// that is, whether in is neither empty nor degenerate.
+func (in *Interval) IsProper() bool { return in.a < in.b }
 
 // IsZero reports whether in is the closed degenerate interval [0, 0].
 func (in *Interval) IsZero() bool { return in.IsSingle() && in.a == 0 }
 
 // Contains reports whether in contains x.
+// Contains reports false if x is an infinity, which is not a real number;
+// use IsLeftUnbounded or IsRightUnbounded to ask whether in is unbounded.
 func (in *Interval) Contains(x float64) bool {
-	return (in.a < x || in.a == x && in.LeftIsClosed()) && (in.b > x || in.b == x && in.RightIsClosed())
+	// Test the common case of an interior point first, and check the closure
+	// of an endpoint only when x is equal to it. This is equivalent to
+	//	(in.a < x || in.a == x && in.LeftIsClosed()) && (in.b > x || in.b == x && in.RightIsClosed())
+	// including when x is NaN, but takes fewer branches.
+	// An infinite endpoint is treated as open even if in was not created by New.
+	return in.a <= x && x <= in.b &&
+		(in.a != x || in.ends&leftEndMask != 0 && in.a != neginf) &&
+		(in.b != x || in.ends&rightEndMask != 0 && in.b != inf)
+}
+
+// InRange reports where x lies relative to in: below its left end,
+// inside it, or above its right end. Exactly one result is true,
+// unless in is empty or x is NaN, in which case all are false.
+// An excluded endpoint is below or above in, so that (2, 4].InRange(2)
+// reports below.
+func (in *Interval) InRange(x float64) (below, inside, above bool) {
+	if in.IsEmpty() {
+		return false, false, false
+	}
+	if in.Contains(x) {
+		return false, true, false
+	}
+	return x <= in.a, false, x >= in.b
+}
+
+// ContainsAll reports whether in contains every value in xs.
+// It reports true if xs is empty.
+func (in *Interval) ContainsAll(xs []float64) bool {
+	for _, x := range xs {
+		if !in.Contains(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny reports whether in contains any value in xs.
+// It reports false if xs is empty.
+func (in *Interval) ContainsAny(xs []float64) bool {
+	for _, x := range xs {
+		if in.Contains(x) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsStrict reports whether x lies strictly between in's endpoints,
+// regardless of whether in contains its endpoints.
+func (in *Interval) ContainsStrict(x float64) bool { return in.a < x && x < in.b }
+
+// ContainsWithin reports whether x is contained in in or lies within
+// a distance tol of one of its endpoints; that is, whether x is contained
+// in the closed interval obtained by widening in by tol at each end.
+// If tol is not positive, ContainsWithin is equivalent to Contains.
+func (in *Interval) ContainsWithin(x, tol float64) bool {
+	switch {
+	case in.Contains(x):
+		return true
+	case in.IsEmpty() || !(tol > 0):
+		return false
+	case x <= in.a:
+		return in.a-x <= tol
+	case x >= in.b:
+		return x-in.b <= tol
+	}
+	return false
+}
+
+// ContainsUlp reports whether x is contained in in or lies within ulps
+// units in the last place of one of its endpoints; that is, whether x is
+// contained in the closed interval obtained by moving each endpoint of in
+// outward ulps times with math.Nextafter. The floats -0 and +0
+// count as one value. If ulps is not positive, ContainsUlp is equivalent to Contains.
+// Like Contains, ContainsUlp reports false if x is an infinity.
+func (in *Interval) ContainsUlp(x float64, ulps int) bool {
+	switch {
+	case in.Contains(x):
+		return true
+	case in.IsEmpty() || ulps <= 0 || math.IsInf(x, 0):
+		return false
+	case x <= in.a:
+		return ulpsBetween(x, in.a) <= uint64(ulps)
+	case x >= in.b:
+		return ulpsBetween(in.b, x) <= uint64(ulps)
+	}
+	return false
+}
+
+// ulpsBetween returns the number of float64 steps from x up to y,
+// for finite x <= y, counting -0 and +0 as one value.
+func ulpsBetween(x, y float64) uint64 { return uint64(ordinal(y) - ordinal(x)) }
+
+// ordinal maps the finite float64s to consecutive integers in increasing order,
+// with -0 and +0 both mapped to 0.
+func ordinal(x float64) int64 {
+	i := int64(math.Float64bits(x))
+	if i < 0 {
+		return math.MinInt64 - i
+	}
+	return i
 }
 
+// ContainsZero reports whether in contains 0.
+// It should be checked before using in as a divisor
+// or as the argument of a function that is singular at 0.
+func (in *Interval) ContainsZero() bool { return in.Contains(0) }
+
 // Equal reports whether x and y represent the same quantity.
 // Two intervals are equal if they are both empty or if they contain the same values.
 func Equal(x, y *Interval) bool {
@@ -105,6 +554,120 @@ func Equal(x, y *Interval) bool {
 	return x.a == y.a && x.b == y.b && x.ends == y.ends
 }
 
+// Hash returns a hash of in that is deterministic across program runs,
+// such that Equal intervals have the same hash.
+// It is the 64-bit FNV-1a hash of the IEEE 754 bits of in's endpoints,
+// with -0 replaced by +0, followed by its Ends.
+// All empty intervals have the hash of the zero Interval.
+func (in *Interval) Hash() uint64 {
+	const (
+		offset64 = 14695981039346656037
+		prime64  = 1099511628211
+	)
+	var c Interval
+	if !in.IsEmpty() {
+		// Adding +0 maps -0 to +0, as in New.
+		c = Interval{in.a + 0, in.b + 0, in.ends}
+	}
+	h := uint64(offset64)
+	for _, w := range [...]uint64{math.Float64bits(c.a), math.Float64bits(c.b), uint64(c.ends)} {
+		for range 8 {
+			h ^= w & 0xff
+			h *= prime64
+			w >>= 8
+		}
+	}
+	return h
+}
+
+// Congruent reports whether x and y have the same shape,
+// that is, whether one is a translation of the other:
+// they have the same width and the same Ends, and they are unbounded
+// in the same directions. Widths are compared as computed in floating point.
+// Empty intervals are congruent to each other and to no other interval.
+func Congruent(x, y *Interval) bool {
+	switch {
+	case x.IsEmpty() || y.IsEmpty():
+		return x.IsEmpty() && y.IsEmpty()
+	case x.ends != y.ends:
+		return false
+	case x.IsLeftUnbounded() || x.IsRightUnbounded() || y.IsLeftUnbounded() || y.IsRightUnbounded():
+		return x.IsLeftUnbounded() == y.IsLeftUnbounded() && x.IsRightUnbounded() == y.IsRightUnbounded()
+	}
+	return x.b-x.a == y.b-y.a
+}
+
+// Adjacent reports whether x and y touch without overlapping:
+// the right endpoint of one is the left endpoint of the other,
+// and exactly one of them contains that point.
+// The union of adjacent intervals is an interval.
+// An empty interval is not adjacent to any interval.
+func Adjacent(x, y *Interval) bool {
+	if x.IsEmpty() || y.IsEmpty() {
+		return false
+	}
+	return x.b == y.a && x.RightIsClosed() != y.LeftIsClosed() ||
+		y.b == x.a && y.RightIsClosed() != x.LeftIsClosed()
+}
+
+// Identical reports whether x and y have the same endpoints and Ends.
+// Unlike Equal, it distinguishes between different representations
+// of the empty interval.
+func Identical(x, y *Interval) bool { return *x == *y }
+
+// Includes reports whether outer contains every value in inner.
+// The empty interval is included in every interval.
+func Includes(outer, inner *Interval) bool {
+	switch {
+	case inner.IsEmpty():
+		return true
+	case outer.IsEmpty():
+		return false
+	}
+	return (outer.a < inner.a || outer.a == inner.a && (outer.LeftIsClosed() || !inner.LeftIsClosed())) &&
+		(outer.b > inner.b || outer.b == inner.b && (outer.RightIsClosed() || !inner.RightIsClosed()))
+}
+
+// PartialCompare compares x and y by inclusion.
+// It returns -1, true if x is a proper subset of y;
+// 1, true if y is a proper subset of x;
+// 0, true if x and y are equal;
+// and 0, false if neither includes the other.
+func PartialCompare(x, y *Interval) (int, bool) {
+	switch {
+	case Equal(x, y):
+		return 0, true
+	case Includes(y, x):
+		return -1, true
+	case Includes(x, y):
+		return 1, true
+	}
+	return 0, false
+}
+
+// Cmp compares x and y in a total order, returning -1 if x precedes y,
+// 0 if x and y are identical, and +1 if x follows y.
+// Empty intervals precede all others. Otherwise, and among empty intervals,
+// Cmp orders x and y by their left endpoints, then by their right endpoints,
+// then by their Ends values. Unlike PartialCompare, Cmp is suitable
+// for sorting, as with slices.SortFunc, and for ordered containers.
+// Cmp(x, y) == 0 if and only if Identical(x, y).
+func Cmp(x, y *Interval) int {
+	if ex, ey := x.IsEmpty(), y.IsEmpty(); ex != ey {
+		if ex {
+			return -1
+		}
+		return 1
+	}
+	if c := cmp.Compare(x.a, y.a); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.b, y.b); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.ends, y.ends)
+}
+
 // LeftIsClosed reports whether in contains its left endpoint,
 // that is, whether its Ends is Closed or LeftClosed.
 func (in *Interval) LeftIsClosed() bool { return in.ends&leftEndMask != 0 }
@@ -113,22 +676,117 @@ func (in *Interval) LeftIsClosed() bool { return in.ends&leftEndMask != 0 }
 // that is, whether its Ends is Closed or RightClosed.
 func (in *Interval) RightIsClosed() bool { return in.ends&rightEndMask != 0 }
 
+// Closure returns the smallest closed interval containing in.
+// Finite endpoints of the result are closed and infinite endpoints are open.
+func (in *Interval) Closure() *Interval {
+	if in.IsEmpty() {
+		return empty()
+	}
+	return &Interval{in.a, in.b, closedEnds(in.a, in.b)}
+}
+
+// closedEnds returns the Ends of the closure of an interval with endpoints a and b.
+func closedEnds(a, b float64) Ends { return EndsFromBools(a != neginf, b != inf) }
+
+// Interior returns the largest open interval contained in in.
+// The interior of a degenerate interval is empty.
+func (in *Interval) Interior() *Interval {
+	if in.IsEmpty() || in.IsSingle() {
+		return empty()
+	}
+	return &Interval{in.a, in.b, Open}
+}
+
+// BoundaryPoints returns the finite endpoints of in in increasing order.
+// These are the points in the Closure of in that are not in its Interior.
+// A degenerate interval has a single boundary point,
+// and an empty interval has none.
+func (in *Interval) BoundaryPoints() []float64 {
+	if in.IsEmpty() {
+		return nil
+	}
+	if in.IsSingle() {
+		return []float64{in.a}
+	}
+	var p []float64
+	if in.a != neginf {
+		p = append(p, in.a)
+	}
+	if in.b != inf {
+		p = append(p, in.b)
+	}
+	return p
+}
+
+// PadToWidth returns in widened symmetrically about its midpoint
+// to the closed interval of the given width, if in is narrower than width.
+// Otherwise, including when in is unbounded, PadToWidth returns a copy of in.
+// The width of the widened interval is subject to rounding.
+// PadToWidth returns an empty interval if in is empty.
+func (in *Interval) PadToWidth(width float64) *Interval {
+	switch {
+	case in.IsEmpty():
+		return empty()
+	case !(in.b-in.a < width):
+		return &Interval{in.a, in.b, in.ends}
+	}
+	mid := in.a/2 + in.b/2
+	a, b := mid-width/2, mid+width/2
+	return &Interval{a, b, closedEnds(a, b)}
+}
+
+// EnsureWidth returns in widened by one unit in the last place at each end
+// to the closed interval [prev(a), next(a)], if in is the single point [a, a].
+// Otherwise, including when in is empty, EnsureWidth returns a copy of in.
+//
+// EnsureWidth is intended to repair a result whose endpoints rounded to the same
+// value although the exact result was a proper interval, as happens when Add
+// or Sub collapses a very thin sum to a single point. The widened interval
+// contains every real number that rounds to a, so it encloses the exact result.
+// It is not appropriate for a point that is exact, such as one returned by
+// NewSingle, which it would needlessly widen, nor can it recover an interval
+// that rounding has made empty, which is indistinguishable from one that
+// was empty to begin with.
+func (in *Interval) EnsureWidth() *Interval {
+	if !in.IsSingle() {
+		return &Interval{in.a, in.b, in.ends}
+	}
+	a, b := math.Nextafter(in.a, neginf), math.Nextafter(in.b, inf)
+	return &Interval{a, b, closedEnds(a, b)}
+}
+
 // empty returns the empty interval (0, 0).
 func empty() *Interval { return &Interval{} }
 
 // zero returns the closed interval [0, 0].
 func zero() *Interval { return &Interval{0, 0, Closed} }
 
+// all returns the interval (-inf, +inf).
+func all() *Interval { return &Interval{neginf, inf, Open} }
+
+// Empty returns the empty interval.
+func Empty() *Interval { return empty() }
+
+// All returns the interval (-inf, +inf) of all real numbers.
+// It is the identity element of Intersection.
+func All() *Interval { return all() }
+
 // Intersection returns the intersection of x and y.
 func Intersection(x, y *Interval) *Interval {
+	in := intersection(*x, *y)
+	return &in
+}
+
+// intersection returns the intersection of x and y as a value.
+func intersection(x, y Interval) Interval {
 	if x.IsEmpty() || y.IsEmpty() {
-		return empty()
+		return Interval{}
 	}
-	if Equal(x, y) {
-		return &Interval{x.a, x.b, x.ends}
+	if Equal(&x, &y) {
+		return Interval{x.a, x.b, x.ends & closedEnds(x.a, x.b)}
 	}
-	if !x.Contains(y.a) && !x.Contains(y.b) && !y.Contains(x.a) && !y.Contains(x.b) {
-		return empty()
+	if !connected(&x, &y) {
+		return Interval{}
 	}
 	var e Ends
 	switch {
@@ -147,20 +805,108 @@ func Intersection(x, y *Interval) *Interval {
 	case x.b > y.b:
 		e ^= y.ends & rightEndMask
 	}
-	return &Interval{math.Max(x.a, y.a), math.Min(x.b, y.b), e}
+	a, b := math.Max(x.a, y.a), math.Min(x.b, y.b)
+	return Interval{a, b, e & closedEnds(a, b)}
+}
+
+// Restrict returns the intersection of in and bound,
+// and reports whether it differs from in,
+// that is, whether in is not a subset of bound.
+func (in *Interval) Restrict(bound *Interval) (result *Interval, changed bool) {
+	result = Intersection(in, bound)
+	return result, !Equal(result, in)
+}
+
+// Finite returns the intersection of in and [lo, hi].
+// Finite returns the empty interval if lo > hi or if either is NaN.
+func (in *Interval) Finite(lo, hi float64) *Interval {
+	if !(lo <= hi) {
+		return empty()
+	}
+	return Intersection(in, &Interval{lo, hi, closedEnds(lo, hi)})
+}
+
+// ClampInterval returns the interval of values of x clamped into bound:
+// values of x less than bound's left endpoint are replaced by that endpoint,
+// and values greater than its right endpoint by that endpoint.
+// Unlike Intersection, it maps the values of x outside bound onto bound
+// rather than dropping them. An endpoint of bound that is reached
+// by clamping is a closed endpoint of the result,
+// even if bound does not contain it.
+// ClampInterval returns the empty interval if x or bound is empty.
+func ClampInterval(x, bound *Interval) *Interval {
+	switch {
+	case x.IsEmpty() || bound.IsEmpty():
+		return empty()
+	case x.b < bound.a || x.b == bound.a && !x.RightIsClosed():
+		return &Interval{bound.a, bound.a, Closed}
+	case x.a > bound.b || x.a == bound.b && !x.LeftIsClosed():
+		return &Interval{bound.b, bound.b, Closed}
+	}
+	in := &Interval{x.a, x.b, x.ends}
+	if x.a < bound.a {
+		in.a, in.ends = bound.a, in.ends|leftEndMask
+	}
+	if x.b > bound.b {
+		in.b, in.ends = bound.b, in.ends|rightEndMask
+	}
+	return in
+}
+
+// TrimLeft returns the part of in that lies to the right of x,
+// the intersection of in and (x, +inf).
+// TrimLeft returns the empty interval if x is NaN.
+func (in *Interval) TrimLeft(x float64) *Interval {
+	if math.IsNaN(x) {
+		return empty()
+	}
+	return Intersection(in, &Interval{x, inf, Open})
+}
+
+// TrimRight returns the part of in that lies to the left of x,
+// the intersection of in and (-inf, x).
+// TrimRight returns the empty interval if x is NaN.
+func (in *Interval) TrimRight(x float64) *Interval {
+	if math.IsNaN(x) {
+		return empty()
+	}
+	return Intersection(in, &Interval{neginf, x, Open})
+}
+
+// connected reports whether the non-empty intervals x and y overlap or touch,
+// that is, whether either contains an endpoint of the other.
+// It is equivalent to, but cheaper than, testing each endpoint with Contains.
+func connected(x, y *Interval) bool {
+	return x.a < y.b && y.a < x.b ||
+		x.b == y.a && (x.ends|y.ends.flip())&rightEndMask != 0 ||
+		y.b == x.a && (y.ends|x.ends.flip())&rightEndMask != 0
 }
 
 // Union returns the union of x and y if their intersection is non-empty,
 // or else the empty interval.
 func Union(x, y *Interval) *Interval {
+	in := union(*x, *y)
+	return &in
+}
+
+// UnionOK is like Union, but also reports whether x and y were merged:
+// that is, whether both are non-empty and they overlap or touch at an endpoint
+// that one of them contains. If ok is false, the result is the empty interval.
+func UnionOK(x, y *Interval) (in *Interval, ok bool) {
+	u := union(*x, *y)
+	return &u, !u.IsEmpty()
+}
+
+// union returns the union of x and y as a value.
+func union(x, y Interval) Interval {
 	if x.IsEmpty() || y.IsEmpty() {
-		return empty()
+		return Interval{}
 	}
-	if Equal(x, y) {
-		return &Interval{x.a, x.b, x.ends}
+	if Equal(&x, &y) {
+		return Interval{x.a, x.b, x.ends & closedEnds(x.a, x.b)}
 	}
-	if !x.Contains(y.a) && !x.Contains(y.b) && !y.Contains(x.a) && !y.Contains(x.b) {
-		return empty()
+	if !connected(&x, &y) {
+		return Interval{}
 	}
 	var e Ends
 	switch {
@@ -179,13 +925,86 @@ func Union(x, y *Interval) *Interval {
 	case x.b > y.b:
 		e ^= x.ends & rightEndMask
 	}
+	a, b := math.Min(x.a, y.a), math.Max(x.b, y.b)
+	return Interval{a, b, e & closedEnds(a, b)}
+}
+
+// Hull returns the smallest interval containing both x and y.
+// Unlike Union, Hull is defined for disjoint x and y,
+// in which case its result also contains the gap between them.
+//
+// Special cases are:
+//
+//	Hull(x, empty) = x
+//	Hull(empty, y) = y
+func Hull(x, y *Interval) *Interval {
+	switch {
+	case x.IsEmpty():
+		return &Interval{y.a, y.b, y.ends}
+	case y.IsEmpty():
+		return &Interval{x.a, x.b, x.ends}
+	}
+	var e Ends
+	switch {
+	case x.a < y.a:
+		e |= x.ends & leftEndMask
+	case x.a == y.a:
+		e |= (x.ends | y.ends) & leftEndMask
+	default:
+		e |= y.ends & leftEndMask
+	}
+	switch {
+	case x.b > y.b:
+		e |= x.ends & rightEndMask
+	case x.b == y.b:
+		e |= (x.ends | y.ends) & rightEndMask
+	default:
+		e |= y.ends & rightEndMask
+	}
 	return &Interval{math.Min(x.a, y.a), math.Max(x.b, y.b), e}
 }
 
+// Bisect splits in at a point m into the disjoint intervals lo,
+// the part of in no greater than m, and hi, the part greater than m,
+// whose union is in. For a bounded interval, m is the midpoint.
+// For an unbounded interval, m is 0 if 0 is an interior point of in,
+// and otherwise twice the finite endpoint plus or minus 1,
+// so that repeated bisection eventually yields bounded intervals.
+// If in is too narrow to split, lo is a copy of in and hi is empty.
+// Bisect returns two empty intervals if in is empty.
+func (in *Interval) Bisect() (lo, hi *Interval) {
+	if in.IsEmpty() {
+		return empty(), empty()
+	}
+	var m float64
+	switch {
+	case in.IsLeftUnbounded() || in.IsRightUnbounded():
+		if m = 0; !(in.a < 0 && 0 < in.b) {
+			m = math.Max(-math.MaxFloat64, math.Min(2*in.a+1, math.MaxFloat64))
+			if in.IsLeftUnbounded() {
+				m = math.Max(-math.MaxFloat64, math.Min(2*in.b-1, math.MaxFloat64))
+			}
+		}
+	default:
+		m = in.a/2 + in.b/2
+	}
+	lo, hi = Intersection(in, &Interval{neginf, m, RightClosed}), in.TrimLeft(m)
+	if lo.IsEmpty() {
+		// in is (MaxFloat64, +inf).
+		return hi, lo
+	}
+	return lo, hi
+}
+
 // String returns a string representation of in.
 // Square brackets denote closed endpoints and parentheses denote open endpoints.
 func (in *Interval) String() string {
-	var l, r string
+	l, r := in.brackets()
+	return fmt.Sprintf("%v%v, %v%v", l, in.a, in.b, r)
+}
+
+// brackets returns the delimiters denoting in's left and right endpoints.
+func (in *Interval) brackets() (l, r string) {
 	if in.LeftIsClosed() {
 		l = "["
 	} else {
@@ -196,5 +1015,5 @@ func (in *Interval) String() string {
 	} else {
 		r = ")"
 	}
-	return fmt.Sprintf("%v%v, %v%v", l, in.a, in.b, r)
+	return l, r
 }
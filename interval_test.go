@@ -1,36 +1,131 @@
 package interval
 
 import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
 	"math"
+	"math/big"
+	"math/rand"
+	"slices"
 	"testing"
 )
 
+var newTests = []struct {
+	x, y float64
+	ends Ends
+	in   *Interval
+	err  error
+}{
+	{math.NaN(), 0, Closed, empty(), ErrNaN},
+	{0, math.NaN(), Closed, empty(), ErrNaN},
+	{0, 0, Open, empty(), ErrEmpty},
+	{0, 0, LeftClosed, empty(), ErrEmpty},
+	{0, 0, RightClosed, empty(), ErrEmpty},
+	{1, -1, Closed, empty(), ErrEmpty},
+	{inf, inf, Open, empty(), ErrEmpty},
+	{neginf, neginf, LeftClosed, empty(), ErrEmpty},
+	{inf, inf, RightClosed, empty(), ErrEmpty},
+	{inf, inf, Closed, empty(), ErrClosedInf},
+	{inf, neginf, Closed, empty(), ErrEmpty},
+	{0, inf, Closed, empty(), ErrClosedInf},
+	{neginf, 0, Closed, empty(), ErrClosedInf},
+	{0, 0, Closed, &Interval{0, 0, Closed}, nil},
+	{1, 1, Closed, &Interval{1, 1, Closed}, nil},
+	{4, 6, Open, &Interval{4, 6, Open}, nil},
+	{-3, inf, LeftClosed, &Interval{-3, inf, LeftClosed}, nil},
+	{neginf, 3, RightClosed, &Interval{neginf, 3, RightClosed}, nil},
+}
+
 func TestNew(t *testing.T) {
+	for _, test := range newTests {
+		if got, err := New(test.x, test.y, test.ends); !Equal(got, test.in) || !errors.Is(err, test.err) {
+			t.Errorf("New(%v, %v, %v): got %v, %v; want %v, %v",
+				test.x, test.y, test.ends, got, err, test.in, test.err,
+			)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	for _, test := range newTests {
+		if err := Check(test.x, test.y, test.ends); !errors.Is(err, test.err) {
+			t.Errorf("Check(%v, %v, %v): got %v, want %v", test.x, test.y, test.ends, err, test.err)
+		}
+	}
+	if n := testing.AllocsPerRun(100, func() { Check(4, 6, Open) }); n != 0 {
+		t.Errorf("Check(4, 6, Open): got %v allocations, want 0", n)
+	}
+}
+
+func TestIntervalError(t *testing.T) {
+	_, errNew := New(2, 1, Closed)
+	_, errDiv := Div(inp1, inz)
+	_, errUnion := Div(inp1, inm)
+	_, errScalar := inp1.DivScalar(0)
+	_, errRat := rat(1, 2, Closed).Div(rat(0, 0, Closed))
+	for _, test := range []struct {
+		err    error
+		target error
+		msg    string
+	}{
+		{errNew, ErrEmpty, "empty interval: New(2, 1, Closed)"},
+		{Check(math.NaN(), 1, Open), ErrNaN, "argument is NaN: New(NaN, 1, Open)"},
+		{Check(neginf, 1, Closed), ErrClosedInf, "closed endpoint of infinite value: New(-Inf, 1, Closed)"},
+		{errDiv, ErrDivByZero, "division by the zero interval: [1, 2] / [0, 0]"},
+		{errUnion, ErrDisjointUnion, "union of disjoint intervals: [1, 2] / [-2, 4]"},
+		{errScalar, ErrDivByZero, "division by the zero interval: [1, 2] / 0"},
+		{errRat, ErrDivByZero, "division by the zero interval: [1, 2] / [0, 0]"},
+	} {
+		var ie *IntervalError
+		if !errors.Is(test.err, test.target) || !errors.As(test.err, &ie) || ie.Err != test.target || test.err.Error() != test.msg {
+			t.Errorf("got error %q, want %q wrapping %v", test.err, test.msg, test.target)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	_, err := Buckets(inp1, 0)
+	err = Explain(err, "Buckets(%v, %v)", inp1, 0)
+	if want := "non-positive bucket count: Buckets([1, 2], 0)"; !errors.Is(err, ErrBucketCount) || err.Error() != want {
+		t.Errorf("Explain: got %q, want %q wrapping %v", err, want, ErrBucketCount)
+	}
+	if err := Explain(nil, "%v / %v", inp1, inp1); err != nil {
+		t.Errorf("Explain(<nil>, ...): got %v, want <nil>", err)
+	}
+}
+
+func TestNewNegativeZero(t *testing.T) {
+	negz := math.Copysign(0, -1)
 	for _, test := range []struct {
 		x, y float64
 		ends Ends
-		in   *Interval
-		err  error
+		want *Interval
 	}{
-		{math.NaN(), 0, Closed, empty(), ErrNaN},
-		{0, math.NaN(), Closed, empty(), ErrNaN},
-		{0, 0, Open, empty(), ErrEmpty},
-		{0, 0, LeftClosed, empty(), ErrEmpty},
-		{0, 0, RightClosed, empty(), ErrEmpty},
-		{1, -1, Closed, empty(), ErrEmpty},
-		{inf, inf, Open, empty(), ErrEmpty},
-		{0, inf, Closed, empty(), ErrClosedInf},
-		{neginf, 0, Closed, empty(), ErrClosedInf},
-		{0, 0, Closed, &Interval{0, 0, Closed}, nil},
-		{1, 1, Closed, &Interval{1, 1, Closed}, nil},
-		{4, 6, Open, &Interval{4, 6, Open}, nil},
-		{-3, inf, LeftClosed, &Interval{-3, inf, LeftClosed}, nil},
-		{neginf, 3, RightClosed, &Interval{neginf, 3, RightClosed}, nil},
-	} {
-		if got, err := New(test.x, test.y, test.ends); !Equal(got, test.in) || err != test.err {
-			t.Errorf("New(%v, %v, %v): got %v, %v; want %v, %v",
-				test.x, test.y, test.ends, got, err, test.in, test.err,
-			)
+		{negz, 1, Closed, &Interval{0, 1, Closed}},
+		{-1, negz, RightClosed, &Interval{-1, 0, RightClosed}},
+		{negz, negz, Closed, &Interval{0, 0, Closed}},
+	} {
+		in, err := New(test.x, test.y, test.ends)
+		if err != nil || !Equal(in, test.want) || in.a == 0 && math.Signbit(in.a) || in.b == 0 && math.Signbit(in.b) {
+			t.Errorf("New(%v, %v, %v): got %v, %v; want %v, <nil>", test.x, test.y, test.ends, in, err, test.want)
+		}
+		if got, want := in.String(), test.want.String(); got != want {
+			t.Errorf("New(%v, %v, %v).String(): got %q, want %q", test.x, test.y, test.ends, got, want)
+		}
+		for _, z := range []float64{0, negz} {
+			if got, want := in.Contains(z), test.want.Contains(0); got != want {
+				t.Errorf("New(%v, %v, %v).Contains(%v): got %v, want %v", test.x, test.y, test.ends, z, got, want)
+			}
+		}
+		// Div treats a zero left endpoint as +0 and a zero right endpoint as -0,
+		// regardless of the sign bit.
+		for _, x := range []*Interval{inp1, inn1, inm} {
+			got, gotErr := Div(x, in)
+			want, wantErr := Div(x, test.want)
+			if !Equal(got, want) || errors.Unwrap(gotErr) != errors.Unwrap(wantErr) {
+				t.Errorf("Div(%v, New(%v, %v, %v)): got %v, %v; want %v, %v", x, test.x, test.y, test.ends, got, gotErr, want, wantErr)
+			}
 		}
 	}
 }
@@ -47,7 +142,7 @@ func TestNewSingle(t *testing.T) {
 		{0, &Interval{0, 0, Closed}, nil},
 		{-3, &Interval{-3, -3, Closed}, nil},
 	} {
-		if got, err := NewSingle(test.x); !Equal(got, test.in) || err != test.err {
+		if got, err := NewSingle(test.x); !Equal(got, test.in) || !errors.Is(err, test.err) {
 			t.Errorf("NewSingle(%v): got %v, %v; want %v, %v",
 				test.x, got, err, test.in, test.err,
 			)
@@ -55,23 +150,395 @@ func TestNewSingle(t *testing.T) {
 	}
 }
 
+func TestHalfLines(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		f    func(float64) (*Interval, error)
+		x    float64
+		in   *Interval
+		err  error
+	}{
+		{"Gt", Gt, 3, &Interval{3, inf, Open}, nil},
+		{"Ge", Ge, 3, &Interval{3, inf, LeftClosed}, nil},
+		{"Lt", Lt, 3, &Interval{neginf, 3, Open}, nil},
+		{"Le", Le, 3, &Interval{neginf, 3, RightClosed}, nil},
+		{"Gt", Gt, neginf, &Interval{neginf, inf, Open}, nil},
+		{"Le", Le, inf, empty(), ErrClosedInf},
+		{"Gt", Gt, math.NaN(), empty(), ErrNaN},
+		{"Ge", Ge, math.NaN(), empty(), ErrNaN},
+		{"Lt", Lt, math.NaN(), empty(), ErrNaN},
+		{"Le", Le, math.NaN(), empty(), ErrNaN},
+	} {
+		if got, err := test.f(test.x); !Equal(got, test.in) || !errors.Is(err, test.err) {
+			t.Errorf("%v(%v): got %v, %v; want %v, %v", test.name, test.x, got, err, test.in, test.err)
+		}
+	}
+}
+
+func TestNewSorted(t *testing.T) {
+	for _, test := range []struct {
+		x, y float64
+		ends Ends
+		in   *Interval
+		err  error
+	}{
+		{math.NaN(), 0, Closed, empty(), ErrNaN},
+		{0, 0, Open, empty(), ErrEmpty},
+		{inf, 0, LeftClosed, empty(), ErrClosedInf},
+		{2, 4, LeftClosed, &Interval{2, 4, LeftClosed}, nil},
+		{4, 2, LeftClosed, &Interval{2, 4, RightClosed}, nil},
+		{4, 2, RightClosed, &Interval{2, 4, LeftClosed}, nil},
+		{4, 2, Closed, &Interval{2, 4, Closed}, nil},
+		{4, 2, Open, &Interval{2, 4, Open}, nil},
+		{3, 3, Closed, &Interval{3, 3, Closed}, nil},
+		{inf, -3, RightClosed, &Interval{-3, inf, LeftClosed}, nil},
+	} {
+		if got, err := NewSorted(test.x, test.y, test.ends); !Equal(got, test.in) || !errors.Is(err, test.err) {
+			t.Errorf("NewSorted(%v, %v, %v): got %v, %v; want %v, %v",
+				test.x, test.y, test.ends, got, err, test.in, test.err,
+			)
+		}
+	}
+}
+
+func TestEnclose(t *testing.T) {
+	for _, test := range []struct {
+		xs  []float64
+		in  *Interval
+		err error
+	}{
+		{nil, empty(), nil},
+		{[]float64{2, math.NaN()}, empty(), ErrNaN},
+		{[]float64{3}, &Interval{3, 3, Closed}, nil},
+		{[]float64{0, 0}, &Interval{0, 0, Closed}, nil},
+		{[]float64{2, -1, 5, 0.5}, &Interval{-1, 5, Closed}, nil},
+		{[]float64{2, inf, 5}, &Interval{2, inf, LeftClosed}, nil},
+		{[]float64{neginf, 2}, &Interval{neginf, 2, RightClosed}, nil},
+		{[]float64{inf, 0, neginf}, &Interval{neginf, inf, Open}, nil},
+		{[]float64{inf, inf}, empty(), ErrEmpty},
+	} {
+		if got, err := Enclose(test.xs...); !Equal(got, test.in) || !errors.Is(err, test.err) {
+			t.Errorf("Enclose(%v): got %v, %v; want %v, %v", test.xs, got, err, test.in, test.err)
+		}
+	}
+}
+
+func TestNewMidRad(t *testing.T) {
+	for _, test := range []struct {
+		mid, rad float64
+		in       *Interval
+		err      error
+	}{
+		{math.NaN(), 1, empty(), ErrNaN},
+		{0, math.NaN(), empty(), ErrNaN},
+		{0, -1, empty(), ErrNegativeRadius},
+		{0, 0, &Interval{0, 0, Closed}, nil},
+		{3, 0.5, &Interval{2.5, 3.5, Closed}, nil},
+		{-2, 2, &Interval{-4, 0, Closed}, nil},
+		{1, 1e-17, &Interval{math.Nextafter(1, 0), math.Nextafter(1, 2), Closed}, nil},
+		{0.1, 0.2, &Interval{-0.1, 0.30000000000000004, Closed}, nil},
+		{math.MaxFloat64, math.MaxFloat64, &Interval{0, inf, LeftClosed}, nil},
+		{0, inf, &Interval{neginf, inf, Open}, nil},
+		{inf, 1, empty(), ErrEmpty},
+	} {
+		if got, err := NewMidRad(test.mid, test.rad); !Equal(got, test.in) || !errors.Is(err, test.err) {
+			t.Errorf("NewMidRad(%v, %v): got %v, %v; want %v, %v", test.mid, test.rad, got, err, test.in, test.err)
+		}
+	}
+}
+
+func TestMidRad(t *testing.T) {
+	for _, test := range []struct {
+		in       *Interval
+		mid, rad float64
+	}{
+		{&Interval{0, 0, Closed}, 0, 0},
+		{&Interval{2, 4, Open}, 3, 1},
+		{&Interval{-1, 0, Closed}, -0.5, 0.5},
+		{&Interval{neginf, 3, RightClosed}, neginf, inf},
+		{&Interval{3, inf, Open}, inf, inf},
+		{&Interval{neginf, inf, Open}, 0, inf},
+	} {
+		if mid, rad := test.in.MidRad(); mid != test.mid || rad != test.rad {
+			t.Errorf("%v.MidRad(): got %v, %v; want %v, %v", test.in, mid, rad, test.mid, test.rad)
+		}
+	}
+	if mid, rad := empty().MidRad(); !math.IsNaN(mid) || !math.IsNaN(rad) {
+		t.Errorf("%v.MidRad(): got %v, %v; want NaN, NaN", empty(), mid, rad)
+	}
+	for _, in := range []*Interval{
+		{0.1, 0.7, Closed},
+		{-0.3, 0.1, Closed},
+		{1.0 / 3, 2.0 / 3, Open},
+		{1e-300, 1e300, Closed},
+		{-math.MaxFloat64, math.MaxFloat64, Closed},
+	} {
+		out, err := NewMidRad(in.MidRad())
+		if err != nil || !Includes(out, in) {
+			t.Errorf("NewMidRad(%v.MidRad()): got %v, %v; want a superset of %v", in, out, err, in)
+		}
+	}
+}
+
+func TestWidth(t *testing.T) {
+	for _, test := range []struct {
+		in            *Interval
+		width, signed float64
+	}{
+		{&Interval{0, 0, Closed}, 0, 0},
+		{&Interval{1, 3, Open}, 2, 2},
+		{&Interval{-2, 4, LeftClosed}, 6, 6},
+		{&Interval{0.1, 0.3, Closed}, 0.19999999999999998, 0.19999999999999998},
+		{&Interval{-1, 1e-20, Closed}, math.Nextafter(1, 2), 1},
+		{&Interval{0, inf, LeftClosed}, inf, inf},
+		{&Interval{neginf, inf, Open}, inf, inf},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Closed}, inf, inf},
+		{&Interval{0, 0, Open}, 0, 0},
+		{&Interval{1, -1, Closed}, 0, -2},
+		{&Interval{3, 2, Open}, 0, -1},
+	} {
+		if got := test.in.Width(); got != test.width {
+			t.Errorf("%v.Width(): got %v, want %v", test.in, got, test.width)
+		}
+		if got := test.in.SignedWidth(); got != test.signed {
+			t.Errorf("SignedWidth(%v): got %v, want %v", *test.in, got, test.signed)
+		}
+	}
+}
+
+func TestEndpointUlps(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		a, b float64
+	}{
+		{&Interval{1, 1e10, Closed}, 0x1p-52, 0x1p-19},
+		{&Interval{-1e10, -1, Closed}, 0x1p-19, 0x1p-52},
+		{&Interval{0, 2, Open}, 5e-324, 0x1p-51},
+		{&Interval{1, inf, LeftClosed}, 0x1p-52, inf},
+		{&Interval{neginf, inf, Open}, inf, inf},
+		{&Interval{math.MaxFloat64, math.MaxFloat64, Closed}, 0x1p971, 0x1p971},
+	} {
+		if a, b := test.in.EndpointUlps(); a != test.a || b != test.b {
+			t.Errorf("%v.EndpointUlps(): got %v, %v; want %v, %v", test.in, a, b, test.a, test.b)
+		}
+	}
+	if a, b := empty().EndpointUlps(); !math.IsNaN(a) || !math.IsNaN(b) {
+		t.Errorf("%v.EndpointUlps(): got %v, %v; want NaN, NaN", empty(), a, b)
+	}
+}
+
+func TestHasSubnormalEndpoint(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		want bool
+	}{
+		{&Interval{5e-324, 1, Closed}, true},
+		{&Interval{-1, -0x1p-1030, Open}, true},
+		{&Interval{-0x1.ffffffffffffep-1023, 0x1p-1022, Closed}, true},
+		{&Interval{0x1p-1022, 1, Closed}, false},
+		{&Interval{-1e300, 1, Closed}, false},
+		{&Interval{0, 0, Closed}, false},
+		{&Interval{math.Copysign(0, -1), 1, Open}, false},
+		{&Interval{neginf, inf, Open}, false},
+		{&Interval{5e-324, 5e-324, Open}, false},
+		{empty(), false},
+	} {
+		if got := test.in.HasSubnormalEndpoint(); got != test.want {
+			t.Errorf("%v.HasSubnormalEndpoint(): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestLerp(t *testing.T) {
+	for _, test := range []struct {
+		in      *Interval
+		t, want float64
+	}{
+		{&Interval{2, 4, Closed}, 0, 2},
+		{&Interval{2, 4, Closed}, 1, 4},
+		{&Interval{2, 4, Open}, 0.5, 3},
+		{&Interval{2, 4, Closed}, 0.25, 2.5},
+		{&Interval{2, 4, Closed}, -1, 0},
+		{&Interval{2, 4, Closed}, 2, 6},
+		{&Interval{3, 3, Closed}, 0.5, 3},
+		{&Interval{neginf, 3, RightClosed}, 0, neginf},
+		{&Interval{neginf, 3, RightClosed}, 1, 3},
+		{&Interval{neginf, 3, RightClosed}, 0.5, neginf},
+		{&Interval{neginf, 3, RightClosed}, 2, inf},
+		{&Interval{3, inf, Open}, 0, 3},
+		{&Interval{3, inf, Open}, 0.5, inf},
+		{&Interval{3, inf, Open}, -1, neginf},
+		{&Interval{neginf, inf, Open}, 0, neginf},
+		{&Interval{neginf, inf, Open}, 1, inf},
+		{&Interval{neginf, inf, Open}, 2, inf},
+		{&Interval{neginf, inf, Open}, -1, neginf},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Closed}, 0.5, 0},
+		{&Interval{-math.MaxFloat64, math.MaxFloat64, Closed}, 1, math.MaxFloat64},
+	} {
+		if got := test.in.Lerp(test.t); got != test.want {
+			t.Errorf("%v.Lerp(%v): got %v, want %v", test.in, test.t, got, test.want)
+		}
+	}
+	for _, in := range []*Interval{empty(), {neginf, inf, Open}} {
+		if got := in.Lerp(0.5); !math.IsNaN(got) {
+			t.Errorf("%v.Lerp(0.5): got %v, want NaN", in, got)
+		}
+	}
+}
+
+func TestMapRange(t *testing.T) {
+	for _, test := range []struct {
+		x        float64
+		from, to *Interval
+		want     float64
+	}{
+		{0, &Interval{0, 100, Closed}, &Interval{-1, 1, Closed}, -1},
+		{100, &Interval{0, 100, Closed}, &Interval{-1, 1, Closed}, 1},
+		{50, &Interval{0, 100, Closed}, &Interval{-1, 1, Closed}, 0},
+		{75, &Interval{0, 100, Open}, &Interval{-1, 1, Open}, 0.5},
+		{150, &Interval{0, 100, Closed}, &Interval{-1, 1, Closed}, 2},
+		{0.1, &Interval{0.1, 0.7, Closed}, &Interval{3, 9, Closed}, 3},
+		{0.7, &Interval{0.1, 0.7, Closed}, &Interval{3, 9, Closed}, 9},
+		{5, &Interval{5, 5, Closed}, &Interval{-1, 1, Closed}, -1},
+		{0, &Interval{0, 1, Closed}, &Interval{3, inf, LeftClosed}, 3},
+		{0.5, &Interval{0, 1, Closed}, &Interval{3, inf, LeftClosed}, inf},
+	} {
+		if got := MapRange(test.x, test.from, test.to); got != test.want {
+			t.Errorf("MapRange(%v, %v, %v): got %v, want %v", test.x, test.from, test.to, got, test.want)
+		}
+	}
+	for _, test := range []struct{ from, to *Interval }{
+		{empty(), &Interval{-1, 1, Closed}},
+		{&Interval{0, 100, Closed}, empty()},
+		{&Interval{0, inf, LeftClosed}, &Interval{-1, 1, Closed}},
+		{&Interval{neginf, 0, RightClosed}, &Interval{-1, 1, Closed}},
+	} {
+		if got := MapRange(0, test.from, test.to); !math.IsNaN(got) {
+			t.Errorf("MapRange(0, %v, %v): got %v, want NaN", test.from, test.to, got)
+		}
+	}
+}
+
+func TestEndsBools(t *testing.T) {
+	for _, test := range []struct {
+		e                       Ends
+		leftClosed, rightClosed bool
+	}{
+		{Open, false, false},
+		{LeftClosed, true, false},
+		{RightClosed, false, true},
+		{Closed, true, true},
+	} {
+		if l, r := test.e.Bools(); l != test.leftClosed || r != test.rightClosed {
+			t.Errorf("%v.Bools(): got %v, %v; want %v, %v", test.e, l, r, test.leftClosed, test.rightClosed)
+		}
+		if got := EndsFromBools(test.leftClosed, test.rightClosed); got != test.e {
+			t.Errorf("EndsFromBools(%v, %v): got %v, want %v", test.leftClosed, test.rightClosed, got, test.e)
+		}
+	}
+}
+
+func TestEndsString(t *testing.T) {
+	for _, test := range []struct {
+		e Ends
+		s string
+	}{
+		{Open, "Open"},
+		{LeftClosed, "LeftClosed"},
+		{RightClosed, "RightClosed"},
+		{Closed, "Closed"},
+	} {
+		if got := test.e.String(); got != test.s {
+			t.Errorf("Ends(%d).String(): got %q, want %q", int(test.e), got, test.s)
+		}
+		if got, err := ParseEnds(test.s); got != test.e || err != nil {
+			t.Errorf("ParseEnds(%q): got %v, %v; want %v, <nil>", test.s, got, err, test.e)
+		}
+	}
+	if got := Ends(4).String(); got != "Ends(4)" {
+		t.Errorf("Ends(4).String(): got %q, want %q", got, "Ends(4)")
+	}
+	for _, s := range []string{"", "open", "Closed ", "Ends(4)"} {
+		if _, err := ParseEnds(s); !errors.Is(err, ErrSyntax) {
+			t.Errorf("ParseEnds(%q): got %v, want %v", s, err, ErrSyntax)
+		}
+	}
+}
+
 var boolTests = []struct {
-	in                                                  Interval
-	empty, mixed, single, zero, leftClosed, rightClosed bool
+	in                                                          Interval
+	empty, mixed, single, zero, leftClosed, rightClosed, proper bool
 }{
-	{Interval{0, 0, Open}, true, false, false, false, false, false},
-	{Interval{0, 0, LeftClosed}, true, false, false, false, true, false},
-	{Interval{0, 0, RightClosed}, true, false, false, false, false, true},
-	{Interval{0, 0, Closed}, false, false, true, true, true, true},
-	{Interval{1, 1, Open}, true, false, false, false, false, false},
-	{Interval{1, 2, Open}, false, false, false, false, false, false},
-	{Interval{-1, 1, Open}, false, true, false, false, false, false},
-	{Interval{1, 1, Closed}, false, false, true, false, true, true},
-	{Interval{1, 2, Closed}, false, false, false, false, true, true},
-	{Interval{-1, 1, Closed}, false, true, false, false, true, true},
-	{Interval{0, inf, LeftClosed}, false, false, false, false, true, false},
-	{Interval{neginf, 0, Open}, false, false, false, false, false, false},
-	{Interval{neginf, inf, Open}, false, true, false, false, false, false},
+	{Interval{0, 0, Open}, true, false, false, false, false, false, false},
+	{Interval{0, 0, LeftClosed}, true, false, false, false, true, false, false},
+	{Interval{0, 0, RightClosed}, true, false, false, false, false, true, false},
+	{Interval{0, 0, Closed}, false, false, true, true, true, true, false},
+	{Interval{1, 1, Open}, true, false, false, false, false, false, false},
+	{Interval{1, 2, Open}, false, false, false, false, false, false, true},
+	{Interval{-1, 1, Open}, false, true, false, false, false, false, true},
+	{Interval{1, 1, Closed}, false, false, true, false, true, true, false},
+	{Interval{1, 2, Closed}, false, false, false, false, true, true, true},
+	{Interval{-1, 1, Closed}, false, true, false, false, true, true, true},
+	{Interval{0, inf, LeftClosed}, false, false, false, false, true, false, true},
+	{Interval{neginf, 0, Open}, false, false, false, false, false, false, true},
+	{Interval{neginf, inf, Open}, false, true, false, false, false, false, true},
+
+	// not created by New
+	{Interval{inf, inf, Closed}, true, false, false, false, true, true, false},
+	{Interval{neginf, neginf, Closed}, true, false, false, false, true, true, false},
+}
+
+// TestPathological checks that Intervals violating the invariants enforced by New
+// neither contain an infinity nor yield NaN or closed infinite endpoints.
+func TestPathological(t *testing.T) {
+	nan := math.NaN()
+	raw := []*Interval{
+		{inf, inf, Open}, {inf, inf, Closed}, {neginf, neginf, Closed},
+		{neginf, inf, Closed}, {0, inf, Closed}, {neginf, 0, Closed},
+		{nan, 1, Closed}, {nan, nan, Closed}, {inf, neginf, Closed},
+	}
+	ins := append([]*Interval{inz, inp0, inp1, inm, inn0, inn1, inpi, inni, inr}, raw...)
+	for _, x := range raw {
+		for _, v := range []float64{inf, neginf} {
+			if x.Contains(v) {
+				t.Errorf("%v.Contains(%v): got true, want false", x, v)
+			}
+		}
+		if (math.IsNaN(x.a) || x.a == x.b) && !x.IsEmpty() {
+			t.Errorf("%v.IsEmpty(): got false, want true", x)
+		}
+		if w := x.Width(); math.IsNaN(w) {
+			t.Errorf("%v.Width(): got %v", x, w)
+		}
+		for _, y := range ins {
+			for _, op := range []struct {
+				name string
+				f    func(x, y *Interval) *Interval
+			}{
+				{"Intersection", Intersection},
+				{"Union", Union},
+			} {
+				for _, got := range []*Interval{op.f(x, y), op.f(y, x)} {
+					if math.IsNaN(got.a) || math.IsNaN(got.b) ||
+						got.a == neginf && got.LeftIsClosed() || got.b == inf && got.RightIsClosed() {
+						t.Errorf("%v(%v, %v): got %v", op.name, x, y, got)
+					}
+				}
+			}
+		}
+	}
+	x := &Interval{0, inf, Closed}
+	if got, want := Union(x, inpi), (&Interval{0, inf, LeftClosed}); !Identical(got, want) {
+		t.Errorf("Union(%v, %v): got %v, want %v", x, inpi, got, want)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	for _, test := range boolTests {
+		if a, b := test.in.Bounds(); a != test.in.Left() || b != test.in.Right() {
+			t.Errorf("Bounds(%v): got %v, %v; want %v, %v", test.in, a, b, test.in.Left(), test.in.Right())
+		}
+	}
 }
 
 func TestIsEmpty(t *testing.T) {
@@ -106,6 +573,69 @@ func TestIsZero(t *testing.T) {
 	}
 }
 
+func TestIsProper(t *testing.T) {
+	for _, test := range boolTests {
+		if got := test.in.IsProper(); got != test.proper {
+			t.Errorf("IsProper(%v): got %v, want %v", test.in, got, test.proper)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	for _, test := range []struct {
+		lo, hi Endpoint
+		want   *Interval
+		err    error
+	}{
+		{Bound(0, Included), Bound(1, Included), &Interval{0, 1, Closed}, nil},
+		{Bound(0, Included), Bound(1, Excluded), &Interval{0, 1, LeftClosed}, nil},
+		{Bound(0, Excluded), Bound(1, Included), &Interval{0, 1, RightClosed}, nil},
+		{Bound(0, Excluded), Bound(1, Excluded), &Interval{0, 1, Open}, nil},
+		{Bound(neginf, Excluded), Bound(inf, Excluded), inr, nil},
+		{Bound(2, Included), Bound(2, Included), &Interval{2, 2, Closed}, nil},
+		{Bound(2, Included), Bound(2, Excluded), ine, ErrEmpty},
+		{Bound(neginf, Included), Bound(0, Included), ine, ErrClosedInf},
+		{Bound(math.NaN(), Included), Bound(0, Included), ine, ErrNaN},
+	} {
+		if got, err := Between(test.lo, test.hi); !Equal(got, test.want) || !errors.Is(err, test.err) {
+			t.Errorf("Between(%+v, %+v): got %v, %v; want %v, %v", test.lo, test.hi, got, err, test.want, test.err)
+		}
+	}
+}
+
+func TestSpan(t *testing.T) {
+	for _, test := range boolTests {
+		s := test.in.Span()
+		if test.empty {
+			if s != (Span{}) {
+				t.Errorf("%v.Span(): got %+v, want %+v", &test.in, s, Span{})
+			}
+			if got, err := FromSpan(s); !got.IsEmpty() || !errors.Is(err, ErrEmpty) {
+				t.Errorf("FromSpan(%+v): got %v, %v; want %v, %v", s, got, err, empty(), ErrEmpty)
+			}
+			continue
+		}
+		if want := (Span{test.in.a, test.in.b, test.leftClosed, test.rightClosed}); s != want {
+			t.Errorf("%v.Span(): got %+v, want %+v", &test.in, s, want)
+		}
+		if got, err := FromSpan(s); !Equal(got, &test.in) || err != nil {
+			t.Errorf("FromSpan(%+v): got %v, %v; want %v, <nil>", s, got, err, &test.in)
+		}
+	}
+	for _, test := range []struct {
+		s   Span
+		err error
+	}{
+		{Span{math.NaN(), 1, true, true}, ErrNaN},
+		{Span{2, 1, true, true}, ErrEmpty},
+		{Span{0, inf, true, true}, ErrClosedInf},
+	} {
+		if got, err := FromSpan(test.s); !got.IsEmpty() || !errors.Is(err, test.err) {
+			t.Errorf("FromSpan(%+v): got %v, %v; want %v, %v", test.s, got, err, empty(), test.err)
+		}
+	}
+}
+
 func TestLeftIsClosed(t *testing.T) {
 	for _, test := range boolTests {
 		if got := test.in.LeftIsClosed(); got != test.leftClosed {
@@ -167,6 +697,345 @@ func TestContains(t *testing.T) {
 	}
 }
 
+func TestInRange(t *testing.T) {
+	type where struct{ below, inside, above bool }
+	var (
+		below  = where{below: true}
+		inside = where{inside: true}
+		above  = where{above: true}
+		none   = where{}
+	)
+	for _, test := range []struct {
+		in   *Interval
+		x    float64
+		want where
+	}{
+		{&Interval{2, 4, RightClosed}, 1, below},
+		{&Interval{2, 4, RightClosed}, 2, below},
+		{&Interval{2, 4, RightClosed}, 3, inside},
+		{&Interval{2, 4, RightClosed}, 4, inside},
+		{&Interval{2, 4, RightClosed}, 5, above},
+		{&Interval{2, 4, LeftClosed}, 2, inside},
+		{&Interval{2, 4, LeftClosed}, 4, above},
+		{&Interval{3, 3, Closed}, 3, inside},
+		{&Interval{3, 3, Closed}, math.Nextafter(3, 0), below},
+		{inpi, inf, above},
+		{inpi, neginf, below},
+		{inr, inf, above},
+		{inr, neginf, below},
+		{inr, 0, inside},
+		{inp1, math.NaN(), none},
+		{ine, 0, none},
+	} {
+		var got where
+		if got.below, got.inside, got.above = test.in.InRange(test.x); got != test.want {
+			t.Errorf("%v.InRange(%v): got %+v, want %+v", test.in, test.x, got, test.want)
+		}
+	}
+}
+
+func TestUnbounded(t *testing.T) {
+	for _, test := range []struct {
+		in          Interval
+		left, right bool
+	}{
+		{Interval{}, false, false},
+		{Interval{inf, inf, Open}, false, false},
+		{Interval{neginf, neginf, Open}, false, false},
+		{Interval{0, 1, Closed}, false, false},
+		{Interval{neginf, 1, RightClosed}, true, false},
+		{Interval{0, inf, LeftClosed}, false, true},
+		{Interval{neginf, inf, Open}, true, true},
+	} {
+		if got := test.in.IsLeftUnbounded(); got != test.left {
+			t.Errorf("IsLeftUnbounded(%v): got %v, want %v", test.in, got, test.left)
+		}
+		if got := test.in.IsRightUnbounded(); got != test.right {
+			t.Errorf("IsRightUnbounded(%v): got %v, want %v", test.in, got, test.right)
+		}
+		for _, x := range []float64{neginf, inf} {
+			if test.in.Contains(x) {
+				t.Errorf("Contains(%v, %v): got true, want false", test.in, x)
+			}
+		}
+	}
+}
+
+func TestContainsAllAny(t *testing.T) {
+	in := &Interval{2, 4, LeftClosed}
+	for _, test := range []struct {
+		xs       []float64
+		all, any bool
+	}{
+		{nil, true, false},
+		{[]float64{}, true, false},
+		{[]float64{2, 3, 3.5}, true, true},
+		{[]float64{2, 3, 4}, false, true},
+		{[]float64{4}, false, false},
+		{[]float64{0, 5, math.NaN()}, false, false},
+	} {
+		if got := in.ContainsAll(test.xs); got != test.all {
+			t.Errorf("%v.ContainsAll(%v): got %v, want %v", in, test.xs, got, test.all)
+		}
+		if got := in.ContainsAny(test.xs); got != test.any {
+			t.Errorf("%v.ContainsAny(%v): got %v, want %v", in, test.xs, got, test.any)
+		}
+	}
+}
+
+func TestContainsStrict(t *testing.T) {
+	for _, test := range []struct {
+		in             Interval
+		x              float64
+		contains, want bool
+	}{
+		{Interval{}, 0, false, false},
+		{Interval{3, 3, Closed}, 3, true, false},
+		{Interval{2, 4, Closed}, 2, true, false},
+		{Interval{2, 4, Closed}, 4, true, false},
+		{Interval{2, 4, Closed}, 3, true, true},
+		{Interval{2, 4, Open}, 2, false, false},
+		{Interval{2, 4, Open}, 3, true, true},
+		{Interval{2, 4, LeftClosed}, 2, true, false},
+		{Interval{2, 4, RightClosed}, 4, true, false},
+		{Interval{2, 4, Closed}, 5, false, false},
+		{Interval{neginf, inf, Open}, 0, true, true},
+		{Interval{neginf, inf, Open}, inf, false, false},
+	} {
+		if got := test.in.Contains(test.x); got != test.contains {
+			t.Errorf("Contains(%v, %v): got %v, want %v", test.in, test.x, got, test.contains)
+		}
+		if got := test.in.ContainsStrict(test.x); got != test.want {
+			t.Errorf("ContainsStrict(%v, %v): got %v, want %v", test.in, test.x, got, test.want)
+		}
+	}
+}
+
+func TestContainsWithin(t *testing.T) {
+	for _, test := range []struct {
+		in     Interval
+		x, tol float64
+		want   bool
+	}{
+		{Interval{}, 0, 1, false},
+		{Interval{0, 1, Closed}, 1.0000001, 1e-6, true},
+		{Interval{0, 1, Closed}, 0.5, 0, true},
+		{Interval{0, 1, Closed}, 1.5, 0.5, true},
+		{Interval{0, 1, Closed}, 1.5, 0.25, false},
+		{Interval{0, 1, Closed}, -0.5, 0.5, true},
+		{Interval{0, 1, Closed}, -0.5, 0.25, false},
+		{Interval{0, 1, Closed}, -0.25, 0.5, true},
+		{Interval{0, 1, Closed}, 2, inf, true},
+		{Interval{0, 1, Open}, 1, 0, false},
+		{Interval{0, 1, Open}, 1, 1e-9, true},
+		{Interval{0, 1, Open}, 0, 1e-9, true},
+		{Interval{0, 1, Closed}, 1.5, -1, false},
+		{Interval{0, 1, Closed}, math.NaN(), 1, false},
+		{Interval{0, inf, LeftClosed}, inf, 1, false},
+	} {
+		if got := test.in.ContainsWithin(test.x, test.tol); got != test.want {
+			t.Errorf("ContainsWithin(%v, %v, %v): got %v, want %v", test.in, test.x, test.tol, got, test.want)
+		}
+	}
+}
+
+func TestContainsUlp(t *testing.T) {
+	next := func(x float64, n int) float64 {
+		dir := math.Copysign(inf, float64(n))
+		for range max(n, -n) {
+			x = math.Nextafter(x, dir)
+		}
+		return x
+	}
+	tiny := math.SmallestNonzeroFloat64
+	for _, test := range []struct {
+		in   Interval
+		x    float64
+		ulps int
+		want bool
+	}{
+		{Interval{0.1, 0.3, Closed}, next(0.3, 1), 1, true},
+		{Interval{0.1, 0.3, Closed}, next(0.3, 1), 0, false},
+		{Interval{0.1, 0.3, Closed}, next(0.3, 2), 1, false},
+		{Interval{0.1, 0.3, Closed}, next(0.3, 2), 2, true},
+		{Interval{0.1, 0.3, Closed}, next(0.1, -1), 1, true},
+		{Interval{0.1, 0.3, Closed}, next(0.1, -1), 0, false},
+		{Interval{0.1, 0.3, Closed}, 0.2, 0, true},
+		{Interval{0.1, 0.3, Open}, 0.3, 0, false},
+		{Interval{0.1, 0.3, Open}, 0.3, 1, true},
+		{Interval{0.1, 0.3, Closed}, next(0.3, 1), -1, false},
+		{Interval{1, 2, Closed}, 0.5, math.MaxInt, true},
+		{Interval{1, 2, Closed}, 0.5, 1 << 52, true},
+		{Interval{1, 2, Closed}, 0.5, 1<<52 - 1, false},
+		// -0 and +0 are one value.
+		{Interval{0, 1, Closed}, -tiny, 1, true},
+		{Interval{tiny, 1, Closed}, -tiny, 1, false},
+		{Interval{tiny, 1, Closed}, -tiny, 2, true},
+		{Interval{-1, -tiny, Closed}, tiny, 2, true},
+		{Interval{-math.MaxFloat64, 0, Closed}, math.MaxFloat64, math.MaxInt, true},
+		{Interval{0, math.MaxFloat64, Closed}, inf, 1, false},
+		{Interval{0, 1, Closed}, math.NaN(), 1, false},
+		{Interval{}, 0, 1, false},
+	} {
+		if got := test.in.ContainsUlp(test.x, test.ulps); got != test.want {
+			t.Errorf("ContainsUlp(%v, %v, %v): got %v, want %v", &test.in, test.x, test.ulps, got, test.want)
+		}
+	}
+}
+
+func TestContainsZero(t *testing.T) {
+	for _, test := range []struct {
+		in   Interval
+		want bool
+	}{
+		{Interval{}, false},
+		{Interval{0, 0, Closed}, true},
+		{Interval{0, 1, Closed}, true},
+		{Interval{0, 1, RightClosed}, false},
+		{Interval{-1, 0, LeftClosed}, false},
+		{Interval{-1, 0, Closed}, true},
+		{Interval{1, 2, Closed}, false},
+		{Interval{-2, -1, Closed}, false},
+		{Interval{-1, 1, Open}, true},
+		{Interval{neginf, inf, Open}, true},
+	} {
+		if got := test.in.ContainsZero(); got != test.want {
+			t.Errorf("ContainsZero(%v): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestClosure(t *testing.T) {
+	for _, test := range []struct{ in, want *Interval }{
+		{&Interval{}, empty()},
+		{&Interval{1, -1, Closed}, empty()},
+		{&Interval{0, 0, Closed}, &Interval{0, 0, Closed}},
+		{&Interval{2, 4, Open}, &Interval{2, 4, Closed}},
+		{&Interval{2, 4, LeftClosed}, &Interval{2, 4, Closed}},
+		{&Interval{2, 4, RightClosed}, &Interval{2, 4, Closed}},
+		{&Interval{2, 4, Closed}, &Interval{2, 4, Closed}},
+		{&Interval{neginf, 3, Open}, &Interval{neginf, 3, RightClosed}},
+		{&Interval{-3, inf, Open}, &Interval{-3, inf, LeftClosed}},
+		{&Interval{neginf, inf, Open}, &Interval{neginf, inf, Open}},
+	} {
+		if got := test.in.Closure(); !Equal(got, test.want) {
+			t.Errorf("%v.Closure(): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestInterior(t *testing.T) {
+	for _, test := range []struct{ in, want *Interval }{
+		{&Interval{}, empty()},
+		{&Interval{0, 0, Closed}, empty()},
+		{&Interval{3, 3, Closed}, empty()},
+		{&Interval{2, 4, Open}, &Interval{2, 4, Open}},
+		{&Interval{2, 4, LeftClosed}, &Interval{2, 4, Open}},
+		{&Interval{2, 4, RightClosed}, &Interval{2, 4, Open}},
+		{&Interval{2, 4, Closed}, &Interval{2, 4, Open}},
+		{&Interval{neginf, 3, RightClosed}, &Interval{neginf, 3, Open}},
+		{&Interval{neginf, inf, Open}, &Interval{neginf, inf, Open}},
+	} {
+		if got := test.in.Interior(); !Equal(got, test.want) {
+			t.Errorf("%v.Interior(): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestBoundaryPoints(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		want []float64
+	}{
+		{&Interval{}, nil},
+		{&Interval{0, 0, Closed}, []float64{0}},
+		{&Interval{-3, -3, Closed}, []float64{-3}},
+		{&Interval{2, 4, Closed}, []float64{2, 4}},
+		{&Interval{2, 4, Open}, []float64{2, 4}},
+		{&Interval{neginf, 3, RightClosed}, []float64{3}},
+		{&Interval{-3, inf, Open}, []float64{-3}},
+		{&Interval{neginf, inf, Open}, nil},
+	} {
+		if got := test.in.BoundaryPoints(); !slices.Equal(got, test.want) {
+			t.Errorf("%v.BoundaryPoints(): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestPadToWidth(t *testing.T) {
+	for _, test := range []struct {
+		in    *Interval
+		width float64
+		want  *Interval
+	}{
+		{empty(), 1, empty()},
+		{&Interval{3, 3, Closed}, 2, &Interval{2, 4, Closed}},
+		{&Interval{1, 1.5, Open}, 2, &Interval{0.25, 2.25, Closed}},
+		{&Interval{1, 1.5, Open}, 0.5, &Interval{1, 1.5, Open}},
+		{&Interval{0, 10, LeftClosed}, 2, &Interval{0, 10, LeftClosed}},
+		{&Interval{0, inf, LeftClosed}, 2, &Interval{0, inf, LeftClosed}},
+		{&Interval{neginf, inf, Open}, inf, &Interval{neginf, inf, Open}},
+		{&Interval{3, 3, Closed}, inf, &Interval{neginf, inf, Open}},
+		{&Interval{3, 3, Closed}, -1, &Interval{3, 3, Closed}},
+		{&Interval{3, 3, Closed}, math.NaN(), &Interval{3, 3, Closed}},
+	} {
+		if got := test.in.PadToWidth(test.width); !Equal(got, test.want) {
+			t.Errorf("%v.PadToWidth(%v): got %v, want %v", test.in, test.width, got, test.want)
+		}
+	}
+	in := &Interval{1, 2, Closed}
+	if got := in.PadToWidth(0.5); got == in {
+		t.Errorf("%v.PadToWidth(0.5): returned its receiver", in)
+	}
+}
+
+func TestEnsureWidth(t *testing.T) {
+	for _, test := range []struct {
+		in, want *Interval
+	}{
+		{empty(), empty()},
+		{&Interval{1, 1, Closed}, &Interval{1 - 0x1p-53, 1 + 0x1p-52, Closed}},
+		{&Interval{0, 0, Closed}, &Interval{-5e-324, 5e-324, Closed}},
+		{&Interval{math.MaxFloat64, math.MaxFloat64, Closed}, &Interval{math.Nextafter(math.MaxFloat64, 0), inf, LeftClosed}},
+		{&Interval{1, 2, LeftClosed}, &Interval{1, 2, LeftClosed}},
+		{&Interval{neginf, inf, Open}, &Interval{neginf, inf, Open}},
+	} {
+		if got := test.in.EnsureWidth(); !Identical(got, test.want) {
+			t.Errorf("%v.EnsureWidth(): got %v, want %v", test.in, got, test.want)
+		}
+	}
+
+	// The exact sum (1+2^-60, 1+2^-59) rounds to the single point [1, 1].
+	x, y := &Interval{1, 1, Closed}, &Interval{0x1p-60, 0x1p-59, Open}
+	sum := Add(x, y)
+	if !sum.IsSingle() {
+		t.Fatalf("Add(%v, %v): got %v, want a single point", x, y, sum)
+	}
+	got := sum.EnsureWidth()
+	lo := new(big.Float).Add(big.NewFloat(x.a), big.NewFloat(y.a))
+	hi := new(big.Float).Add(big.NewFloat(x.b), big.NewFloat(y.b))
+	if big.NewFloat(got.a).Cmp(lo) > 0 || big.NewFloat(got.b).Cmp(hi) < 0 {
+		t.Errorf("Add(%v, %v).EnsureWidth(): got %v, want an enclosure of (%v, %v)", x, y, got, lo, hi)
+	}
+}
+
+func TestEmptyAll(t *testing.T) {
+	if in := Empty(); !in.IsEmpty() {
+		t.Errorf("Empty(): got %v, want empty", in)
+	}
+	if in := All(); !Equal(in, &Interval{neginf, inf, Open}) {
+		t.Errorf("All(): got %v, want %v", in, &Interval{neginf, inf, Open})
+	}
+	for _, x := range []float64{0, -1, 2.5, math.MaxFloat64, -math.MaxFloat64, math.SmallestNonzeroFloat64} {
+		if !All().Contains(x) {
+			t.Errorf("All().Contains(%v): got false, want true", x)
+		}
+		if Empty().Contains(x) {
+			t.Errorf("Empty().Contains(%v): got true, want false", x)
+		}
+	}
+}
+
 var setTests = []struct{ x, y, intersection, union *Interval }{
 	{
 		&Interval{0, 0, Closed},
@@ -212,6 +1081,305 @@ var setTests = []struct{ x, y, intersection, union *Interval }{
 	},
 }
 
+func TestRestrict(t *testing.T) {
+	for _, test := range []struct {
+		in, bound, want *Interval
+		changed         bool
+	}{
+		{empty(), &Interval{0, 1, Closed}, empty(), false},
+		{&Interval{0, 1, Closed}, empty(), empty(), true},
+		{&Interval{2, 3, Closed}, &Interval{0, 10, Closed}, &Interval{2, 3, Closed}, false},
+		{&Interval{0, 10, Closed}, &Interval{0, 10, Closed}, &Interval{0, 10, Closed}, false},
+		{&Interval{0, 10, Closed}, &Interval{0, 10, LeftClosed}, &Interval{0, 10, LeftClosed}, true},
+		{&Interval{neginf, 5, Open}, &Interval{0, 10, Closed}, &Interval{0, 5, LeftClosed}, true},
+		{&Interval{0, 1, Closed}, &Interval{2, 3, Closed}, empty(), true},
+		{&Interval{0, 1, Closed}, &Interval{neginf, inf, Open}, &Interval{0, 1, Closed}, false},
+	} {
+		if got, changed := test.in.Restrict(test.bound); !Equal(got, test.want) || changed != test.changed {
+			t.Errorf("%v.Restrict(%v): got %v, %v; want %v, %v", test.in, test.bound, got, changed, test.want, test.changed)
+		}
+	}
+}
+
+func TestFinite(t *testing.T) {
+	for _, test := range []struct {
+		in     *Interval
+		lo, hi float64
+		want   *Interval
+	}{
+		{empty(), -10, 10, empty()},
+		{&Interval{neginf, 3, RightClosed}, -10, 10, &Interval{-10, 3, Closed}},
+		{&Interval{neginf, inf, Open}, -10, 10, &Interval{-10, 10, Closed}},
+		{&Interval{neginf, inf, Open}, 0, 0, &Interval{0, 0, Closed}},
+		{&Interval{2, inf, Open}, -10, 10, &Interval{2, 10, RightClosed}},
+		{&Interval{2, 4, Open}, -10, 10, &Interval{2, 4, Open}},
+		{&Interval{20, 40, Closed}, -10, 10, empty()},
+		{&Interval{neginf, inf, Open}, 10, -10, empty()},
+		{&Interval{neginf, inf, Open}, math.NaN(), 10, empty()},
+		{&Interval{neginf, 3, RightClosed}, neginf, 10, &Interval{neginf, 3, RightClosed}},
+	} {
+		if got := test.in.Finite(test.lo, test.hi); !Equal(got, test.want) {
+			t.Errorf("%v.Finite(%v, %v): got %v, want %v", test.in, test.lo, test.hi, got, test.want)
+		}
+	}
+}
+
+func TestClampInterval(t *testing.T) {
+	bound := &Interval{0, 3, Closed}
+	for _, test := range []struct{ x, bound, want *Interval }{
+		{empty(), bound, empty()},
+		{&Interval{0, 1, Closed}, empty(), empty()},
+		{&Interval{-1, 5, Closed}, bound, &Interval{0, 3, Closed}},
+		{&Interval{-1, 5, Open}, bound, &Interval{0, 3, Closed}},
+		{&Interval{-1, 2, Open}, bound, &Interval{0, 2, LeftClosed}},
+		{&Interval{1, 5, Open}, bound, &Interval{1, 3, RightClosed}},
+		{&Interval{1, 2, Open}, bound, &Interval{1, 2, Open}},
+		{&Interval{0, 3, Open}, bound, &Interval{0, 3, Open}},
+		{&Interval{-5, -1, Closed}, bound, &Interval{0, 0, Closed}},
+		{&Interval{-5, 0, Open}, bound, &Interval{0, 0, Closed}},
+		{&Interval{4, inf, Open}, bound, &Interval{3, 3, Closed}},
+		{&Interval{3, inf, Open}, bound, &Interval{3, 3, Closed}},
+		{&Interval{neginf, inf, Open}, bound, &Interval{0, 3, Closed}},
+		{&Interval{-1, 5, Closed}, &Interval{0, 3, Open}, &Interval{0, 3, Closed}},
+		{&Interval{-1, 5, Closed}, &Interval{0, inf, LeftClosed}, &Interval{0, 5, Closed}},
+		{&Interval{neginf, inf, Open}, &Interval{neginf, 2, Open}, &Interval{neginf, 2, RightClosed}},
+	} {
+		if got := ClampInterval(test.x, test.bound); !Equal(got, test.want) {
+			t.Errorf("ClampInterval(%v, %v): got %v, want %v", test.x, test.bound, got, test.want)
+		}
+	}
+}
+
+func TestTrim(t *testing.T) {
+	for _, test := range []struct {
+		in          *Interval
+		x           float64
+		left, right *Interval
+	}{
+		{empty(), 0, empty(), empty()},
+		{&Interval{0, 4, Closed}, 1, &Interval{1, 4, RightClosed}, &Interval{0, 1, LeftClosed}},
+		{&Interval{0, 4, Open}, 1, &Interval{1, 4, Open}, &Interval{0, 1, Open}},
+		{&Interval{0, 4, Closed}, 0, &Interval{0, 4, RightClosed}, empty()},
+		{&Interval{0, 4, Closed}, 4, empty(), &Interval{0, 4, LeftClosed}},
+		{&Interval{0, 4, Open}, 0, &Interval{0, 4, Open}, empty()},
+		{&Interval{0, 4, LeftClosed}, -1, &Interval{0, 4, LeftClosed}, empty()},
+		{&Interval{0, 4, LeftClosed}, 5, empty(), &Interval{0, 4, LeftClosed}},
+		{&Interval{2, 2, Closed}, 2, empty(), empty()},
+		{&Interval{neginf, inf, Open}, 3, &Interval{3, inf, Open}, &Interval{neginf, 3, Open}},
+		{&Interval{0, inf, LeftClosed}, neginf, &Interval{0, inf, LeftClosed}, empty()},
+		{&Interval{0, inf, LeftClosed}, inf, empty(), &Interval{0, inf, LeftClosed}},
+		{&Interval{0, 4, Closed}, math.NaN(), empty(), empty()},
+	} {
+		if got := test.in.TrimLeft(test.x); !Equal(got, test.left) {
+			t.Errorf("%v.TrimLeft(%v): got %v, want %v", test.in, test.x, got, test.left)
+		}
+		if got := test.in.TrimRight(test.x); !Equal(got, test.right) {
+			t.Errorf("%v.TrimRight(%v): got %v, want %v", test.in, test.x, got, test.right)
+		}
+	}
+}
+
+func TestAdjacent(t *testing.T) {
+	for _, test := range []struct {
+		x, y *Interval
+		want bool
+	}{
+		{&Interval{0, 1, LeftClosed}, &Interval{1, 2, Closed}, true},
+		{&Interval{0, 1, Closed}, &Interval{1, 2, RightClosed}, true},
+		{&Interval{0, 1, Closed}, &Interval{1, 2, Closed}, false},
+		{&Interval{0, 1, LeftClosed}, &Interval{1, 2, RightClosed}, false},
+		{&Interval{0, 1, Open}, &Interval{1, 2, LeftClosed}, true},
+		{&Interval{0, 1, RightClosed}, &Interval{1, 2, Open}, true},
+		{&Interval{0, 1, LeftClosed}, &Interval{1.5, 2, Closed}, false},
+		{&Interval{0, 2, Closed}, &Interval{1, 3, Closed}, false},
+		{&Interval{1, 1, Closed}, &Interval{1, 2, Open}, true},
+		{&Interval{1, 1, Closed}, &Interval{1, 2, Closed}, false},
+		{&Interval{neginf, 0, Open}, &Interval{0, inf, LeftClosed}, true},
+		{empty(), &Interval{0, 1, Closed}, false},
+		{&Interval{0, 0, Open}, &Interval{0, 1, Open}, false},
+	} {
+		if got := Adjacent(test.x, test.y); got != test.want {
+			t.Errorf("Adjacent(%v, %v): got %v, want %v", test.x, test.y, got, test.want)
+		}
+		if got := Adjacent(test.y, test.x); got != test.want {
+			t.Errorf("Adjacent(%v, %v): got %v, want %v", test.y, test.x, got, test.want)
+		}
+	}
+}
+
+func TestIdentical(t *testing.T) {
+	for _, test := range []struct {
+		x, y             *Interval
+		identical, equal bool
+	}{
+		{&Interval{0, 0, Closed}, &Interval{0, 0, Closed}, true, true},
+		{&Interval{0, 1, Open}, &Interval{0, 1, Open}, true, true},
+		{&Interval{0, 1, Open}, &Interval{0, 1, LeftClosed}, false, false},
+		{empty(), empty(), true, true},
+		{empty(), &Interval{1, 1, Open}, false, true},
+		{&Interval{0, 0, LeftClosed}, &Interval{2, 1, Closed}, false, true},
+		{&Interval{neginf, inf, Open}, all(), true, true},
+	} {
+		if got := Identical(test.x, test.y); got != test.identical {
+			t.Errorf("Identical(%v, %v): got %v, want %v", test.x, test.y, got, test.identical)
+		}
+		if got := Equal(test.x, test.y); got != test.equal {
+			t.Errorf("Equal(%v, %v): got %v, want %v", test.x, test.y, got, test.equal)
+		}
+	}
+}
+
+func TestCongruent(t *testing.T) {
+	for _, test := range []struct {
+		x, y *Interval
+		want bool
+	}{
+		{empty(), empty(), true},
+		{empty(), &Interval{0, 0, Closed}, false},
+		{&Interval{0, 2, Closed}, &Interval{5, 7, Closed}, true},
+		{&Interval{0, 2, Closed}, &Interval{0, 3, Closed}, false},
+		{&Interval{0, 2, Closed}, &Interval{5, 7, RightClosed}, false},
+		{&Interval{-1, 1, Open}, &Interval{9, 11, Open}, true},
+		{&Interval{3, 3, Closed}, &Interval{-4, -4, Closed}, true},
+		{&Interval{0, inf, LeftClosed}, &Interval{5, inf, LeftClosed}, true},
+		{&Interval{0, inf, Open}, &Interval{neginf, 0, Open}, false},
+		{&Interval{neginf, inf, Open}, &Interval{neginf, inf, Open}, true},
+		{&Interval{0, inf, Open}, &Interval{neginf, inf, Open}, false},
+	} {
+		if got := Congruent(test.x, test.y); got != test.want {
+			t.Errorf("Congruent(%v, %v): got %v, want %v", test.x, test.y, got, test.want)
+		}
+		if got := Congruent(test.y, test.x); got != test.want {
+			t.Errorf("Congruent(%v, %v): got %v, want %v", test.y, test.x, got, test.want)
+		}
+	}
+}
+
+func TestCmp(t *testing.T) {
+	for _, test := range []struct {
+		x, y *Interval
+		want int
+	}{
+		{ine, ine, 0},
+		{ine, inn1, -1},
+		{inp1, ine, 1},
+		{&Interval{0, 0, Open}, &Interval{2, 1, Closed}, -1},
+		{inn1, inp1, -1},
+		{&Interval{1, 2, Closed}, &Interval{1, 3, Open}, -1},
+		{&Interval{1, 2, Open}, &Interval{1, 2, Closed}, -1},
+		{&Interval{1, 2, RightClosed}, &Interval{1, 2, LeftClosed}, 1},
+		{&Interval{1, 2, Closed}, &Interval{1, 2, Closed}, 0},
+		{inr, inni, 1},
+	} {
+		if got := Cmp(test.x, test.y); got != test.want {
+			t.Errorf("Cmp(%v, %v): got %v, want %v", test.x, test.y, got, test.want)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	ins := []*Interval{ine, {0, 0, LeftClosed}, {2, 1, Closed}, {3, 3, Open}}
+	// Draw from few endpoints so that many intervals share one.
+	for range 60 {
+		a, b := float64(r.Intn(5)-2), float64(r.Intn(5)-2)
+		if r.Intn(8) == 0 {
+			a = neginf
+		}
+		ins = append(ins, &Interval{a, b, Ends(r.Intn(4)) & closedEnds(a, b)})
+	}
+	for _, x := range ins {
+		for _, y := range ins {
+			c := Cmp(x, y)
+			if c != -Cmp(y, x) {
+				t.Errorf("Cmp(%v, %v) = %v, Cmp(%v, %v) = %v", x, y, c, y, x, Cmp(y, x))
+			}
+			if (c == 0) != Identical(x, y) {
+				t.Errorf("Cmp(%v, %v) = %v, Identical(%v, %v) = %v", x, y, c, x, y, Identical(x, y))
+			}
+			for _, z := range ins {
+				if c <= 0 && Cmp(y, z) <= 0 && Cmp(x, z) > 0 {
+					t.Errorf("Cmp(%v, %v) = %v, Cmp(%v, %v) = %v, Cmp(%v, %v) = %v", x, y, c, y, z, Cmp(y, z), x, z, Cmp(x, z))
+				}
+			}
+		}
+	}
+	slices.SortFunc(ins, Cmp)
+	if !slices.IsSortedFunc(ins, Cmp) || !ins[0].IsEmpty() || ins[len(ins)-1].IsEmpty() {
+		t.Errorf("SortFunc(ins, Cmp): got %v", ins)
+	}
+}
+
+func TestHash(t *testing.T) {
+	negz := math.Copysign(0, -1)
+	for _, test := range []struct {
+		x, y *Interval
+	}{
+		{ine, &Interval{2, 1, Closed}},
+		{ine, &Interval{3, 3, LeftClosed}},
+		{&Interval{0, 1, Closed}, &Interval{negz, 1, Closed}},
+		{&Interval{-1, 0, RightClosed}, &Interval{-1, negz, RightClosed}},
+	} {
+		if !Equal(test.x, test.y) || test.x.Hash() != test.y.Hash() {
+			t.Errorf("%v.Hash() = %#x, %v.Hash() = %#x", test.x, test.x.Hash(), test.y, test.y.Hash())
+		}
+	}
+	// The hash is FNV-1a of the little-endian encoding of the fields,
+	// so it does not depend on the process.
+	for _, in := range []*Interval{ine, inz, inp1, inni, inr} {
+		h := fnv.New64a()
+		for _, w := range []uint64{math.Float64bits(in.a), math.Float64bits(in.b), uint64(in.ends)} {
+			h.Write(binary.LittleEndian.AppendUint64(nil, w))
+		}
+		if got, want := in.Hash(), h.Sum64(); got != want {
+			t.Errorf("%v.Hash(): got %#x, want %#x", in, got, want)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	hashes := make(map[uint64]*Interval)
+	for i := 0; i < 10000; i++ {
+		in := randomIntInterval(r)
+		c := &Interval{in.a, in.b, in.ends}
+		if in.Hash() != c.Hash() {
+			t.Errorf("%v.Hash() = %#x, %v.Hash() = %#x", in, in.Hash(), c, c.Hash())
+		}
+		if prev, ok := hashes[in.Hash()]; ok && !Equal(prev, in) {
+			t.Errorf("%v.Hash() = %v.Hash() = %#x", prev, in, in.Hash())
+		}
+		hashes[in.Hash()] = in
+	}
+}
+
+func TestPartialCompare(t *testing.T) {
+	for _, test := range []struct {
+		x, y *Interval
+		cmp  int
+		ok   bool
+	}{
+		{empty(), empty(), 0, true},
+		{empty(), &Interval{0, 1, Closed}, -1, true},
+		{&Interval{0, 1, Closed}, empty(), 1, true},
+		{&Interval{0, 1, Closed}, &Interval{0, 1, Closed}, 0, true},
+		{&Interval{0, 1, Open}, &Interval{0, 1, Closed}, -1, true},
+		{&Interval{0, 1, LeftClosed}, &Interval{0, 1, RightClosed}, 0, false},
+		{&Interval{1, 2, Closed}, &Interval{0, 5, Open}, -1, true},
+		{&Interval{0, 5, Open}, &Interval{0, 5, LeftClosed}, -1, true},
+		{&Interval{0, 5, Closed}, &Interval{3, 8, Closed}, 0, false},
+		{&Interval{0, 1, Closed}, &Interval{2, 3, Closed}, 0, false},
+		{&Interval{neginf, inf, Open}, &Interval{2, 3, Closed}, 1, true},
+		{&Interval{neginf, 0, RightClosed}, &Interval{neginf, 0, Open}, 1, true},
+	} {
+		if cmp, ok := PartialCompare(test.x, test.y); cmp != test.cmp || ok != test.ok {
+			t.Errorf("PartialCompare(%v, %v): got %v, %v; want %v, %v", test.x, test.y, cmp, ok, test.cmp, test.ok)
+		}
+		if got, want := Includes(test.x, test.y), test.ok && test.cmp >= 0; got != want {
+			t.Errorf("Includes(%v, %v): got %v, want %v", test.x, test.y, got, want)
+		}
+		if got, want := Includes(test.y, test.x), test.ok && test.cmp <= 0; got != want {
+			t.Errorf("Includes(%v, %v): got %v, want %v", test.y, test.x, got, want)
+		}
+	}
+}
+
 func TestIntersection(t *testing.T) {
 	for _, test := range setTests {
 		if got := Intersection(test.x, test.y); !Equal(got, test.intersection) {
@@ -227,3 +1395,201 @@ func TestUnion(t *testing.T) {
 		}
 	}
 }
+
+func TestUnionOK(t *testing.T) {
+	for _, test := range []struct {
+		x, y *Interval
+		want *Interval
+		ok   bool
+	}{
+		{&Interval{0, 2, Closed}, &Interval{1, 3, Open}, &Interval{0, 3, LeftClosed}, true},
+		{&Interval{0, 1, Closed}, &Interval{1, 2, Closed}, &Interval{0, 2, Closed}, true},
+		{&Interval{0, 1, LeftClosed}, &Interval{1, 2, Closed}, &Interval{0, 2, Closed}, true},
+		{&Interval{0, 1, Open}, &Interval{1, 2, Open}, empty(), false},
+		{&Interval{0, 1, Closed}, &Interval{2, 3, Closed}, empty(), false},
+		{&Interval{0, 1, Closed}, empty(), empty(), false},
+		{empty(), empty(), empty(), false},
+	} {
+		if got, ok := UnionOK(test.x, test.y); !Equal(got, test.want) || ok != test.ok {
+			t.Errorf("UnionOK(%v, %v): got %v, %v; want %v, %v", test.x, test.y, got, ok, test.want, test.ok)
+		}
+	}
+	for _, test := range setTests {
+		if got, ok := UnionOK(test.x, test.y); !Equal(got, test.union) || ok == test.union.IsEmpty() {
+			t.Errorf("UnionOK(%v, %v): got %v, %v; want %v, %v", test.x, test.y, got, ok, test.union, !test.union.IsEmpty())
+		}
+	}
+}
+
+func TestHull(t *testing.T) {
+	for _, test := range []struct {
+		x, y, want *Interval
+	}{
+		{ine, ine, ine},
+		{inp1, ine, inp1},
+		{ine, inn1, inn1},
+		{inp1, inn1, &Interval{-8, 2, Closed}},
+		{&Interval{1, 2, Open}, &Interval{3, 4, LeftClosed}, &Interval{1, 4, Open}},
+		{&Interval{1, 2, Open}, &Interval{1, 4, LeftClosed}, &Interval{1, 4, LeftClosed}},
+		{inpi, inni, inr},
+	} {
+		if got := Hull(test.x, test.y); !Equal(got, test.want) {
+			t.Errorf("Hull(%v, %v): got %v, want %v", test.x, test.y, got, test.want)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		x, y := randomIntInterval(r), randomIntInterval(r)
+		h := Hull(x, y)
+		if !Includes(h, x) || !Includes(h, y) || !Equal(h, Hull(y, x)) {
+			t.Errorf("Hull(%v, %v): got %v", x, y, h)
+		}
+		if u := Union(x, y); !u.IsEmpty() && !Equal(h, u) {
+			t.Errorf("Hull(%v, %v): got %v, want Union %v", x, y, h, u)
+		}
+	}
+}
+
+func TestBisect(t *testing.T) {
+	for _, test := range []struct {
+		in, lo, hi *Interval
+	}{
+		{ine, ine, ine},
+		{inz, inz, ine},
+		{&Interval{0, 2, Closed}, &Interval{0, 1, Closed}, &Interval{1, 2, RightClosed}},
+		{&Interval{0, 2, Open}, &Interval{0, 1, RightClosed}, &Interval{1, 2, Open}},
+		{&Interval{1, 1 + 0x1p-52, Closed}, &Interval{1, 1, Closed}, &Interval{1, 1 + 0x1p-52, RightClosed}},
+		{inr, &Interval{neginf, 0, RightClosed}, &Interval{0, inf, Open}},
+		{inpi, &Interval{1, 3, Closed}, &Interval{3, inf, Open}},
+		{&Interval{0, inf, Open}, &Interval{0, 1, RightClosed}, &Interval{1, inf, Open}},
+		{inni, &Interval{neginf, -3, RightClosed}, &Interval{-3, -1, RightClosed}},
+		{&Interval{neginf, 0, RightClosed}, &Interval{neginf, -1, RightClosed}, &Interval{-1, 0, RightClosed}},
+		{&Interval{0, inf, LeftClosed}, &Interval{0, 1, Closed}, &Interval{1, inf, Open}},
+		{&Interval{math.MaxFloat64, inf, Open}, &Interval{math.MaxFloat64, inf, Open}, ine},
+	} {
+		if lo, hi := test.in.Bisect(); !Equal(lo, test.lo) || !Equal(hi, test.hi) {
+			t.Errorf("%v.Bisect(): got %v, %v; want %v, %v", test.in, lo, hi, test.lo, test.hi)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		in := RandomInterval(r)
+		lo, hi := in.Bisect()
+		if !Intersection(lo, hi).IsEmpty() || !Equal(Hull(lo, hi), in) || lo.IsEmpty() {
+			t.Errorf("%v.Bisect(): got %v, %v", in, lo, hi)
+		}
+		// An unbounded interval is split unless nothing lies beyond ±MaxFloat64.
+		if (in.IsLeftUnbounded() || in.IsRightUnbounded()) && in.a != math.MaxFloat64 && in.b != -math.MaxFloat64 && hi.IsEmpty() {
+			t.Errorf("%v.Bisect(): got %v, %v; want two non-empty intervals", in, lo, hi)
+		}
+	}
+}
+
+// Intersection and Union are computed exactly, so they are commutative,
+// and Intersection is associative. Union is associative
+// where it is defined, that is, where neither side is empty
+// because of a union of disjoint intervals.
+
+func TestSetCommutative(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		x, y := RandomInterval(r), RandomInterval(r)
+		if xy, yx := Intersection(x, y), Intersection(y, x); !Equal(xy, yx) {
+			t.Errorf("Intersection(%v, %v) = %v, Intersection(%v, %v) = %v", x, y, xy, y, x, yx)
+		}
+		if xy, yx := Union(x, y), Union(y, x); !Equal(xy, yx) {
+			t.Errorf("Union(%v, %v) = %v, Union(%v, %v) = %v", x, y, xy, y, x, yx)
+		}
+	}
+}
+
+func TestSetAssociative(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		x, y, z := RandomInterval(r), RandomInterval(r), RandomInterval(r)
+		if lhs, rhs := Intersection(Intersection(x, y), z), Intersection(x, Intersection(y, z)); !Equal(lhs, rhs) {
+			t.Errorf("Intersection(Intersection(%v, %v), %v) = %v, Intersection(%v, Intersection(%v, %v)) = %v",
+				x, y, z, lhs, x, y, z, rhs,
+			)
+		}
+		xy, yz := Union(x, y), Union(y, z)
+		if xy.IsEmpty() || yz.IsEmpty() {
+			continue
+		}
+		if lhs, rhs := Union(xy, z), Union(x, yz); !Equal(lhs, rhs) {
+			t.Errorf("Union(Union(%v, %v), %v) = %v, Union(%v, Union(%v, %v)) = %v", x, y, z, lhs, x, y, z, rhs)
+		}
+	}
+}
+
+func TestConnected(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		x, y := randomIntInterval(r), randomIntInterval(r)
+		want := x.Contains(y.a) || x.Contains(y.b) || y.Contains(x.a) || y.Contains(x.b)
+		if !Equal(x, y) && connected(x, y) != want {
+			t.Errorf("connected(%v, %v): got %v, want %v", x, y, !want, want)
+		}
+	}
+}
+
+func BenchmarkIntersection(b *testing.B) {
+	for _, bm := range []struct {
+		name string
+		x, y *Interval
+	}{
+		{"overlapping", &Interval{1, 3, Closed}, &Interval{2, 4, LeftClosed}},
+		{"disjoint", &Interval{1, 2, Closed}, &Interval{3, 4, LeftClosed}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				benchResult = Intersection(bm.x, bm.y)
+			}
+		})
+	}
+}
+
+func BenchmarkUnion(b *testing.B) {
+	for _, bm := range []struct {
+		name string
+		x, y *Interval
+	}{
+		{"overlapping", &Interval{1, 3, Closed}, &Interval{2, 4, LeftClosed}},
+		{"disjoint", &Interval{1, 2, Closed}, &Interval{3, 4, LeftClosed}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				benchResult = Union(bm.x, bm.y)
+			}
+		})
+	}
+}
+
+func BenchmarkContains(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	xs := make([]float64, 1024)
+	for i := range xs {
+		// Include the endpoints, to exercise the closure checks.
+		xs[i] = float64(r.Intn(9)-4) / 2
+		if r.Intn(2) == 0 {
+			xs[i] += r.Float64()
+		}
+	}
+	in := &Interval{-1, 1, LeftClosed}
+	var n int
+	b.ReportAllocs()
+	for b.Loop() {
+		for _, x := range xs {
+			if in.Contains(x) {
+				n++
+			}
+		}
+	}
+	benchCount = n
+}
+
+var benchCount int
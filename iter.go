@@ -0,0 +1,206 @@
+package interval
+
+import (
+	"iter"
+	"math"
+	"math/big"
+)
+
+// IntSeq returns an iterator over the integers contained in in
+// that are within the range of int, in increasing order.
+// IntSeq panics if in is non-empty and unbounded.
+func (in *Interval) IntSeq() iter.Seq[int] {
+	lo, hi := in.intBounds()
+	return func(yield func(int) bool) {
+		if lo > hi {
+			return
+		}
+		// Stop at hi before incrementing, which would overflow if hi is math.MaxInt.
+		for i := lo; ; i++ {
+			if !yield(i) || i == hi {
+				return
+			}
+		}
+	}
+}
+
+// intBounds returns the least and greatest integers contained in in
+// that are within the range of int.
+// If in contains no such integers, lo > hi.
+// intBounds panics if in is non-empty and unbounded.
+func (in *Interval) intBounds() (lo, hi int) {
+	if in.IsEmpty() {
+		return 0, -1
+	}
+	if math.IsInf(in.a, 0) || math.IsInf(in.b, 0) {
+		panic("interval: integers of unbounded interval " + in.String())
+	}
+	// Every int lies in [-two63, two63).
+	const two63 = 1 << 63
+	a, b := math.Ceil(in.a), math.Floor(in.b)
+	if a >= two63 || b < -two63 {
+		return 0, -1
+	}
+	// An open endpoint is excluded after conversion to int,
+	// since a float64 of large magnitude may not be incremented exactly.
+	lo, hi = math.MinInt, math.MaxInt
+	if a >= -two63 {
+		lo = int(a)
+		if a == in.a && !in.LeftIsClosed() {
+			lo++
+		}
+	}
+	if b < two63 {
+		hi = int(b)
+		if b == in.b && !in.RightIsClosed() {
+			if hi == math.MinInt {
+				return 0, -1
+			}
+			hi--
+		}
+	}
+	return lo, hi
+}
+
+// FromHalfOpen returns the interval [lo, hi) of the half-open integer range
+// used in Go slice expressions.
+// If lo == hi, FromHalfOpen returns an empty interval and a nil error.
+// If lo > hi, it returns an empty interval and ErrEmpty.
+func FromHalfOpen(lo, hi int) (*Interval, error) {
+	if lo == hi {
+		return empty(), nil
+	}
+	return New(float64(lo), float64(hi), LeftClosed)
+}
+
+// HalfOpenInts returns the integers contained in in as a half-open range [lo, hi),
+// so that lo <= i < hi for each such integer i. If in contains no integers, lo == hi.
+// ok is false if in is unbounded, contains integers outside the range of int,
+// or contains more integers than hi-lo can count without overflow.
+func (in *Interval) HalfOpenInts() (lo, hi int, ok bool) {
+	if !in.IsEmpty() && (in.a < math.MinInt || in.b >= math.MaxInt) {
+		return 0, 0, false
+	}
+	lo, hi = in.intBounds()
+	if hi < lo {
+		return lo, lo, true
+	}
+	if hi+1-lo < 0 {
+		return 0, 0, false
+	}
+	return lo, hi + 1, true
+}
+
+// Ints returns the integers contained in in, in increasing order.
+// It returns nil if in contains no integers or if HalfOpenInts returns ok == false.
+// Ints allocates the whole slice at once, so a caller that cannot bound
+// in's width in advance should check the count hi-lo reported by
+// HalfOpenInts first, or iterate with IntSeq instead.
+func (in *Interval) Ints() []int {
+	lo, hi, ok := in.HalfOpenInts()
+	if !ok || lo == hi {
+		return nil
+	}
+	s := make([]int, 0, hi-lo)
+	for i := lo; i < hi; i++ {
+		s = append(s, i)
+	}
+	return s
+}
+
+// ContainsInts reports whether in contains every integer in xs.
+// It reports true if xs is empty.
+func (in *Interval) ContainsInts(xs []int) bool {
+	for _, x := range xs {
+		if !in.containsInt(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsInt reports whether in contains x,
+// which float64(x) may not represent exactly.
+func (in *Interval) containsInt(x int) bool {
+	if f := float64(x); -1<<53 <= f && f <= 1<<53 {
+		return in.Contains(f)
+	}
+	if in.IsEmpty() {
+		return false
+	}
+	xf := new(big.Float).SetInt64(int64(x))
+	lo, hi := xf.Cmp(big.NewFloat(in.a)), xf.Cmp(big.NewFloat(in.b))
+	return (lo > 0 || lo == 0 && in.LeftIsClosed()) && (hi < 0 || hi == 0 && in.RightIsClosed())
+}
+
+// StepSeq returns an iterator over the values a, a+step, a+2*step, ...
+// that are contained in in, where a is in's left endpoint.
+// The last value may fall short of in's right endpoint.
+// Each value is rounded to the nearest float64 and is yielded only if it
+// exceeds the previous one, so a step smaller than the spacing of float64s
+// near a does not yield the same value more than once.
+// An empty interval yields no values.
+// StepSeq panics if step is not positive or if in is non-empty and unbounded.
+func (in *Interval) StepSeq(step float64) iter.Seq[float64] {
+	if !(step > 0) || math.IsInf(step, 1) {
+		panic("interval: non-positive or infinite step")
+	}
+	if !in.IsEmpty() && (math.IsInf(in.a, 0) || math.IsInf(in.b, 0)) {
+		panic("interval: steps over unbounded interval " + in.String())
+	}
+	return func(yield func(float64) bool) {
+		if in.IsEmpty() {
+			return
+		}
+		prev := neginf
+		for k := 0; ; k++ {
+			x := in.a + float64(k)*step
+			if x > in.b {
+				return
+			}
+			if x > prev && in.Contains(x) {
+				if !yield(x) {
+					return
+				}
+				prev = x
+			}
+		}
+	}
+}
+
+// StepRange returns a generator of the successive windows
+// [start+k*step, start+(k+1)*step], for k = 0, 1, 2, ..., clipped to domain.
+// Windows that do not meet domain are skipped, as is a final window
+// that meets domain only at the right endpoint of the previous one.
+// Once the windows pass domain's right endpoint,
+// the generator returns an empty interval and false.
+// StepRange panics if step is not positive and finite or if start is not finite.
+func StepRange(domain *Interval, start, step float64) func() (*Interval, bool) {
+	if !(step > 0) || math.IsInf(step, 1) {
+		panic("interval: non-positive or infinite step")
+	}
+	if math.IsNaN(start) || math.IsInf(start, 0) {
+		panic("interval: non-finite start")
+	}
+	var k float64
+	if domain.a > start && !math.IsInf(domain.a, 0) {
+		k = math.Floor((domain.a - start) / step)
+	}
+	var yielded bool
+	return func() (*Interval, bool) {
+		for !domain.IsEmpty() {
+			lo, hi := start+k*step, start+(k+1)*step
+			if lo > domain.b || lo == domain.b && yielded {
+				break
+			}
+			k++
+			w := Intersection(domain, &Interval{lo, hi, Closed})
+			if w.IsEmpty() {
+				continue
+			}
+			yielded = true
+			return w, true
+		}
+		return empty(), false
+	}
+}
@@ -0,0 +1,301 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestIntSeq(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		want []int
+	}{
+		{empty(), nil},
+		{&Interval{0, 3, Closed}, []int{0, 1, 2, 3}},
+		{&Interval{0, 3, Open}, []int{1, 2}},
+		{&Interval{0, 3, LeftClosed}, []int{0, 1, 2}},
+		{&Interval{0, 3, RightClosed}, []int{1, 2, 3}},
+		{&Interval{-2, 2, Closed}, []int{-2, -1, 0, 1, 2}},
+		{&Interval{-0.5, 0.5, Open}, []int{0}},
+		{&Interval{0.2, 0.8, Closed}, nil},
+		{&Interval{4, 4, Closed}, []int{4}},
+	} {
+		if got := slices.Collect(test.in.IntSeq()); !slices.Equal(got, test.want) {
+			t.Errorf("%v.IntSeq(): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestIntSeqBreak(t *testing.T) {
+	var got []int
+	for i := range (&Interval{0, 10, Closed}).IntSeq() {
+		if i == 3 {
+			break
+		}
+		got = append(got, i)
+	}
+	if want := []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("IntSeq with break: got %v, want %v", got, want)
+	}
+}
+
+func TestIntSeqLarge(t *testing.T) {
+	for _, test := range []struct {
+		in          *Interval
+		first, last int
+		n           int
+	}{
+		{&Interval{1e19, 1e19, Closed}, 0, 0, 0},
+		{&Interval{-1e19, -1e19, Closed}, 0, 0, 0},
+		{&Interval{1 << 60, 1<<60 + 256, Open}, 1<<60 + 1, 1<<60 + 255, 255},
+		{&Interval{1 << 60, 1<<60 + 256, Closed}, 1 << 60, 1<<60 + 256, 257},
+		{&Interval{1<<63 - 2048, 1e19, LeftClosed}, 1<<63 - 2048, math.MaxInt, 2048},
+		{&Interval{-1e19, -1<<63 + 2048, Closed}, math.MinInt, -1<<63 + 2048, 2049},
+		{&Interval{-1e19, -1 << 63, Open}, 0, 0, 0},
+	} {
+		var first, last, n int
+		for i := range test.in.IntSeq() {
+			if n == 0 {
+				first = i
+			}
+			last = i
+			n++
+		}
+		if first != test.first || last != test.last || n != test.n {
+			t.Errorf("%v.IntSeq(): got %v integers from %v to %v, want %v from %v to %v", test.in, n, first, last, test.n, test.first, test.last)
+		}
+	}
+}
+
+func TestIntSeqUnbounded(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%v.IntSeq(): did not panic", &Interval{0, inf, LeftClosed})
+		}
+	}()
+	(&Interval{0, inf, LeftClosed}).IntSeq()
+}
+
+func TestHalfOpen(t *testing.T) {
+	for _, test := range []struct {
+		lo, hi int
+		in     *Interval
+		err    error
+	}{
+		{0, 3, &Interval{0, 3, LeftClosed}, nil},
+		{-2, 5, &Interval{-2, 5, LeftClosed}, nil},
+		{4, 5, &Interval{4, 5, LeftClosed}, nil},
+		{3, 3, empty(), nil},
+		{4, 3, empty(), ErrEmpty},
+	} {
+		in, err := FromHalfOpen(test.lo, test.hi)
+		if !Equal(in, test.in) || !errors.Is(err, test.err) {
+			t.Errorf("FromHalfOpen(%v, %v): got %v, %v; want %v, %v", test.lo, test.hi, in, err, test.in, test.err)
+		}
+		if in.IsEmpty() {
+			continue
+		}
+		if lo, hi, ok := in.HalfOpenInts(); lo != test.lo || hi != test.hi || !ok {
+			t.Errorf("%v.HalfOpenInts(): got %v, %v, %v; want %v, %v, true", in, lo, hi, ok, test.lo, test.hi)
+		}
+	}
+}
+
+func TestHalfOpenInts(t *testing.T) {
+	for _, test := range []struct {
+		in     *Interval
+		lo, hi int
+		ok     bool
+	}{
+		{empty(), 0, 0, true},
+		{&Interval{0, 3, Closed}, 0, 4, true},
+		{&Interval{0, 3, Open}, 1, 3, true},
+		{&Interval{0, 3, RightClosed}, 1, 4, true},
+		{&Interval{-1.5, 1.5, Closed}, -1, 2, true},
+		{&Interval{0.2, 0.8, Closed}, 1, 1, true},
+		{&Interval{4, 4, Closed}, 4, 5, true},
+		{&Interval{0, inf, LeftClosed}, 0, 0, false},
+		{&Interval{neginf, 0, Open}, 0, 0, false},
+		{&Interval{0, 1e300, Closed}, 0, 0, false},
+		{&Interval{-6e18, 6e18, Closed}, 0, 0, false},
+		{&Interval{-4e18, 4e18, Closed}, -4e18, 4e18 + 1, true},
+	} {
+		if lo, hi, ok := test.in.HalfOpenInts(); lo != test.lo || hi != test.hi || ok != test.ok {
+			t.Errorf("%v.HalfOpenInts(): got %v, %v, %v; want %v, %v, %v", test.in, lo, hi, ok, test.lo, test.hi, test.ok)
+		}
+	}
+}
+
+func TestInts(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		want []int
+	}{
+		{&Interval{0, 4, RightClosed}, []int{1, 2, 3, 4}},
+		{&Interval{0, 4, LeftClosed}, []int{0, 1, 2, 3}},
+		{&Interval{0, 4, Closed}, []int{0, 1, 2, 3, 4}},
+		{&Interval{0, 4, Open}, []int{1, 2, 3}},
+		{&Interval{-2.5, 0.5, Open}, []int{-2, -1, 0}},
+		{&Interval{7, 7, Closed}, []int{7}},
+		{&Interval{0.2, 0.8, Closed}, nil},
+		{&Interval{0, 1, Open}, nil},
+		{empty(), nil},
+		{&Interval{0, inf, LeftClosed}, nil},
+		{&Interval{neginf, 0, Open}, nil},
+		{&Interval{0, 1e300, Closed}, nil},
+		{&Interval{-6e18, 6e18, Closed}, nil},
+	} {
+		if got := test.in.Ints(); !slices.Equal(got, test.want) || (got == nil) != (test.want == nil) {
+			t.Errorf("%v.Ints(): got %v, want %v", test.in, got, test.want)
+		}
+	}
+}
+
+func TestContainsInts(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		xs   []int
+		want bool
+	}{
+		{&Interval{0, 10, Closed}, []int{0, 3, 7, 10}, true},
+		{&Interval{0, 10, Closed}, []int{3, 11}, false},
+		{&Interval{0, 10, Closed}, []int{-1, 3}, false},
+		{&Interval{0, 10, LeftClosed}, []int{0, 9}, true},
+		{&Interval{0, 10, LeftClosed}, []int{0, 10}, false},
+		{&Interval{0, 10, RightClosed}, []int{0}, false},
+		{&Interval{-0.5, 0.5, Open}, []int{0}, true},
+		{&Interval{0, inf, LeftClosed}, []int{0, math.MaxInt}, true},
+		{&Interval{neginf, inf, Open}, []int{math.MinInt, math.MaxInt}, true},
+		{&Interval{0, 10, Closed}, nil, true},
+		{empty(), nil, true},
+		{empty(), []int{0}, false},
+		// float64(1<<60 + 1) rounds to 1<<60.
+		{&Interval{1 << 60, 1 << 60, Closed}, []int{1<<60 + 1}, false},
+		{&Interval{1 << 60, 1 << 61, LeftClosed}, []int{1 << 60, 1<<60 + 1, 1<<61 - 1}, true},
+		{&Interval{1 << 60, 1 << 61, Open}, []int{1 << 60}, false},
+		{&Interval{0, 1 << 63, Open}, []int{math.MaxInt}, true},
+	} {
+		if got := test.in.ContainsInts(test.xs); got != test.want {
+			t.Errorf("%v.ContainsInts(%v): got %v, want %v", test.in, test.xs, got, test.want)
+		}
+	}
+}
+
+func TestStepSeq(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		step float64
+		want []float64
+	}{
+		{empty(), 1, nil},
+		{&Interval{0, 1, Closed}, 0.25, []float64{0, 0.25, 0.5, 0.75, 1}},
+		{&Interval{0, 1, Open}, 0.25, []float64{0.25, 0.5, 0.75}},
+		{&Interval{0, 1, Closed}, 0.4, []float64{0, 0.4, 0.8}},
+		{&Interval{0, 1, Closed}, 2, []float64{0}},
+		{&Interval{-1, 1, RightClosed}, 1, []float64{0, 1}},
+		{&Interval{3, 3, Closed}, 1, []float64{3}},
+		{&Interval{1e20, 1e20 + 1e5, Closed}, 1, []float64{1e20, 1e20 + 16384, 1e20 + 2*16384, 1e20 + 3*16384, 1e20 + 4*16384, 1e20 + 5*16384, 1e20 + 6*16384}},
+	} {
+		if got := slices.Collect(test.in.StepSeq(test.step)); !slices.Equal(got, test.want) {
+			t.Errorf("%v.StepSeq(%v): got %v, want %v", test.in, test.step, got, test.want)
+		}
+	}
+}
+
+func TestStepSeqPanics(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		step float64
+	}{
+		{&Interval{0, 1, Closed}, 0},
+		{&Interval{0, 1, Closed}, -1},
+		{&Interval{0, 1, Closed}, inf},
+		{&Interval{0, inf, LeftClosed}, 1},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%v.StepSeq(%v): did not panic", test.in, test.step)
+				}
+			}()
+			test.in.StepSeq(test.step)
+		}()
+	}
+}
+
+func TestStepRange(t *testing.T) {
+	for _, test := range []struct {
+		domain      *Interval
+		start, step float64
+		want        []*Interval
+	}{
+		{empty(), 0, 1, nil},
+		{&Interval{0, 10, Closed}, 0, 3, []*Interval{
+			{0, 3, Closed}, {3, 6, Closed}, {6, 9, Closed}, {9, 10, Closed},
+		}},
+		{&Interval{0, 9, Open}, 0, 3, []*Interval{
+			{0, 3, RightClosed}, {3, 6, Closed}, {6, 9, LeftClosed},
+		}},
+		{&Interval{0, 9, Closed}, 0, 3, []*Interval{
+			{0, 3, Closed}, {3, 6, Closed}, {6, 9, Closed},
+		}},
+		{&Interval{5, 10, Closed}, 0, 3, []*Interval{
+			{5, 6, Closed}, {6, 9, Closed}, {9, 10, Closed},
+		}},
+		{&Interval{0, 4, Closed}, 2.5, 1, []*Interval{
+			{2.5, 3.5, Closed}, {3.5, 4, Closed},
+		}},
+		{&Interval{2, 2, Closed}, 0, 2, []*Interval{{2, 2, Closed}}},
+		{&Interval{0, 1, Closed}, 2, 1, nil},
+	} {
+		var got []*Interval
+		next := StepRange(test.domain, test.start, test.step)
+		for w, ok := next(); ok; w, ok = next() {
+			got = append(got, w)
+		}
+		if !slices.EqualFunc(got, test.want, Equal) {
+			t.Errorf("StepRange(%v, %v, %v): got %v, want %v", test.domain, test.start, test.step, got, test.want)
+		}
+		if w, ok := next(); ok || !w.IsEmpty() {
+			t.Errorf("StepRange(%v, %v, %v) after exhaustion: got %v, %v; want %v, false", test.domain, test.start, test.step, w, ok, empty())
+		}
+	}
+}
+
+func TestStepRangeCoverage(t *testing.T) {
+	domain := &Interval{0, 10, Closed}
+	next := StepRange(domain, 0, 3)
+	var windows []*Interval
+	for w, ok := next(); ok; w, ok = next() {
+		if n := len(windows); n > 0 && windows[n-1].b != w.a {
+			t.Errorf("StepRange(%v, 0, 3): window %v does not abut %v", domain, w, windows[n-1])
+		}
+		windows = append(windows, w)
+	}
+	for x := range domain.StepSeq(0.125) {
+		if !slices.ContainsFunc(windows, func(w *Interval) bool { return w.Contains(x) }) {
+			t.Errorf("StepRange(%v, 0, 3): %v is not covered by %v", domain, x, windows)
+		}
+	}
+}
+
+func TestStepRangePanics(t *testing.T) {
+	for _, test := range []struct{ start, step float64 }{
+		{0, 0},
+		{0, -1},
+		{0, inf},
+		{math.NaN(), 1},
+		{neginf, 1},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("StepRange(%v, %v, %v): did not panic", &Interval{0, 1, Closed}, test.start, test.step)
+				}
+			}()
+			StepRange(&Interval{0, 1, Closed}, test.start, test.step)
+		}()
+	}
+}
@@ -0,0 +1,81 @@
+package interval
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// A CompactInterval is an Interval that is encoded in JSON as the array
+// [a, b, ends] of its endpoints and its Ends as an integer,
+// which is smaller than an object with named fields.
+// Because JSON numbers cannot represent infinities, an infinite endpoint
+// is encoded as the string "+Inf" or "-Inf". The empty interval is encoded
+// as [0, 0, 0]. Convert between *Interval and *CompactInterval to encode
+// and decode intervals in this form:
+//
+//	b, err := json.Marshal((*CompactInterval)(in))
+//	err = json.Unmarshal(b, (*CompactInterval)(in))
+type CompactInterval Interval
+
+// MarshalJSON implements json.Marshaler.
+func (c CompactInterval) MarshalJSON() ([]byte, error) {
+	in := (*Interval)(&c)
+	if in.IsEmpty() {
+		return []byte("[0,0,0]"), nil
+	}
+	return json.Marshal([3]any{jsonEndpoint(in.a), jsonEndpoint(in.b), int(in.ends)})
+}
+
+// jsonEndpoint returns x, or its string representation if x is infinite.
+func jsonEndpoint(x float64) any {
+	if math.IsInf(x, 0) {
+		return strconv.FormatFloat(x, 'g', -1, 64)
+	}
+	return x
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the array form produced by MarshalJSON.
+// An array whose endpoints are equal and not both closed decodes as the empty interval.
+// UnmarshalJSON returns an error wrapping ErrSyntax if data is not of that form,
+// or an error from New if the interval it describes is invalid.
+// If UnmarshalJSON returns a non-nil error, it leaves c unchanged.
+func (c *CompactInterval) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil || len(raw) != 3 {
+		return fmt.Errorf("%w: %s", ErrSyntax, data)
+	}
+	x, okx := parseJSONEndpoint(raw[0])
+	y, oky := parseJSONEndpoint(raw[1])
+	var ends Ends
+	if err := json.Unmarshal(raw[2], &ends); err != nil || !okx || !oky || ends < Open || ends > Closed {
+		return fmt.Errorf("%w: %s", ErrSyntax, data)
+	}
+	if x == y && ends != Closed {
+		*c = CompactInterval{}
+		return nil
+	}
+	in, err := New(x, y, ends)
+	if err != nil {
+		return err
+	}
+	*c = CompactInterval(*in)
+	return nil
+}
+
+// parseJSONEndpoint returns the value of the JSON number or string data,
+// and reports whether data is either.
+func parseJSONEndpoint(data json.RawMessage) (float64, bool) {
+	var x float64
+	if err := json.Unmarshal(data, &x); err == nil {
+		return x, true
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return 0, false
+	}
+	x, err := strconv.ParseFloat(s, 64)
+	return x, err == nil
+}
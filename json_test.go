@@ -0,0 +1,81 @@
+package interval
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestCompactInterval(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		json string
+	}{
+		{&Interval{0, 1, Closed}, `[0,1,3]`},
+		{&Interval{-2.5, 1e300, LeftClosed}, `[-2.5,1e+300,1]`},
+		{&Interval{3, 3, Closed}, `[3,3,3]`},
+		{&Interval{neginf, 0, RightClosed}, `["-Inf",0,2]`},
+		{&Interval{neginf, inf, Open}, `["-Inf","+Inf",0]`},
+		{empty(), `[0,0,0]`},
+		{&Interval{2, 1, Closed}, `[0,0,0]`},
+	} {
+		b, err := json.Marshal((*CompactInterval)(test.in))
+		if string(b) != test.json || err != nil {
+			t.Errorf("Marshal(%v): got %s, %v; want %s, <nil>", test.in, b, err, test.json)
+		}
+		var got Interval
+		if err := json.Unmarshal(b, (*CompactInterval)(&got)); !Equal(&got, test.in) || err != nil {
+			t.Errorf("Unmarshal(%s): got %v, %v; want %v, <nil>", b, &got, err, test.in)
+		}
+	}
+
+	// Within a larger structure, by value.
+	type sample struct {
+		Name  string
+		Range CompactInterval
+	}
+	v := sample{"x", CompactInterval{1, inf, LeftClosed}}
+	b, err := json.Marshal(v)
+	if want := `{"Name":"x","Range":[1,"+Inf",1]}`; string(b) != want || err != nil {
+		t.Errorf("Marshal(%+v): got %s, %v; want %s, <nil>", v, b, err, want)
+	}
+	var w sample
+	if err := json.Unmarshal(b, &w); w != v || err != nil {
+		t.Errorf("Unmarshal(%s): got %+v, %v; want %+v, <nil>", b, w, err, v)
+	}
+
+	for _, test := range []struct {
+		json string
+		err  error
+	}{
+		{`[0,1]`, ErrSyntax},
+		{`[0,1,3,4]`, ErrSyntax},
+		{`{"Lo":0}`, ErrSyntax},
+		{`[0,"x",3]`, ErrSyntax},
+		{`[0,1,4]`, ErrSyntax},
+		{`[0,1,"Closed"]`, ErrSyntax},
+		{`[2,1,3]`, ErrEmpty},
+		{`["-Inf",1,3]`, ErrClosedInf},
+		{`["NaN",1,3]`, ErrNaN},
+	} {
+		in := &Interval{5, 6, Closed}
+		if err := json.Unmarshal([]byte(test.json), (*CompactInterval)(in)); !errors.Is(err, test.err) || !Equal(in, &Interval{5, 6, Closed}) {
+			t.Errorf("Unmarshal(%s): got %v, %v; want %v, %v", test.json, in, err, &Interval{5, 6, Closed}, test.err)
+		}
+	}
+}
+
+func TestCompactIntervalSize(t *testing.T) {
+	in := &Interval{-1.25, 3.5, LeftClosed}
+	compact, err := json.Marshal((*CompactInterval)(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	object, err := json.Marshal(in.Span())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(compact) >= len(object) {
+		t.Errorf("compact form %s is not smaller than object form %s", compact, object)
+	}
+}
@@ -0,0 +1,101 @@
+package interval
+
+import "errors"
+
+// ErrSingular is returned by SolveGS when a diagonal element of the matrix contains zero.
+var ErrSingular = errors.New("diagonal element contains zero")
+
+// ErrNoSolution is returned by SolveGS when the initial enclosure
+// contains no solution of the system.
+var ErrNoSolution = errors.New("no solution in enclosure")
+
+// ErrNoConvergence is returned by SolveGS when the iteration
+// does not reach a fixed point within the allotted number of sweeps.
+var ErrNoConvergence = errors.New("iteration did not converge")
+
+// A Vector is a vector of intervals.
+type Vector []*Interval
+
+// A Matrix is a matrix of intervals, stored as a slice of rows.
+type Matrix [][]*Interval
+
+// MatVec returns the product of m and v, the vector whose i-th element
+// is the dot product of the i-th row of m with v as computed by Dot.
+// MatVec returns a nil Vector and ErrLength if any row of m
+// does not have the same length as v.
+func MatVec(m Matrix, v Vector) (Vector, error) {
+	w := make(Vector, len(m))
+	for i, row := range m {
+		in, err := Dot(row, v)
+		if err != nil {
+			return nil, err
+		}
+		w[i] = in
+	}
+	return w, nil
+}
+
+// SolveGS tightens the enclosure x0 of the solutions of the linear system a*x = b
+// by interval Gauss-Seidel iteration. Each sweep replaces each element x[i]
+// in turn with its intersection with
+//
+//	(b[i] - sum of a[i][j]*x[j] for j != i) / a[i][i]
+//
+// where the sum is computed by Dot. SolveGS performs at most iters sweeps,
+// stopping early once a sweep leaves x unchanged, and returns the resulting enclosure.
+// Every solution of a*x = b for point coefficients in a and b that lies in x0
+// also lies in the result, up to the rounding of the operations used.
+//
+// SolveGS returns a nil Vector and ErrLength if a is not square or if b or x0
+// does not have the same length as a, ErrSingular if a diagonal element of a
+// contains zero, and ErrNoSolution if an element of the enclosure becomes empty,
+// which shows that x0 contains no solution.
+// If the enclosure has not reached a fixed point after iters sweeps,
+// SolveGS returns it together with ErrNoConvergence; the enclosure
+// remains valid, but further iteration may tighten it.
+func SolveGS(a Matrix, b Vector, x0 Vector, iters int) (Vector, error) {
+	n := len(a)
+	if len(b) != n || len(x0) != n {
+		return nil, ErrLength
+	}
+	for i, row := range a {
+		if len(row) != n {
+			return nil, ErrLength
+		}
+		if row[i].ContainsZero() {
+			return nil, ErrSingular
+		}
+	}
+	x := make(Vector, n)
+	copy(x, x0)
+	row, xs := make([]*Interval, 0, n), make([]*Interval, 0, n)
+	for range iters {
+		changed := false
+		for i := range x {
+			row, xs = row[:0], xs[:0]
+			for j := range x {
+				if j != i {
+					row, xs = append(row, a[i][j]), append(xs, x[j])
+				}
+			}
+			s, err := Dot(row, xs)
+			if err != nil {
+				return nil, err
+			}
+			// The diagonal element does not contain zero,
+			// so the quotient is a single interval.
+			q, _ := Div(Sub(b[i], s), a[i][i])
+			in := Intersection(x[i], q)
+			if in.IsEmpty() {
+				return nil, ErrNoSolution
+			}
+			if !Equal(in, x[i]) {
+				x[i], changed = in, true
+			}
+		}
+		if !changed {
+			return x, nil
+		}
+	}
+	return x, ErrNoConvergence
+}
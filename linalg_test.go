@@ -0,0 +1,136 @@
+package interval
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestMatVec(t *testing.T) {
+	m := Matrix{
+		{{1, 2, Closed}, {-1, 1, Closed}},
+		{{0, 0.5, Closed}, {3, 4, LeftClosed}},
+	}
+	v := Vector{{2, 3, Closed}, {-1, 0, RightClosed}}
+	want := Vector{{1, 7, Open}, {-4, 1.5, RightClosed}}
+	got, err := MatVec(m, v)
+	if err != nil || len(got) != len(want) {
+		t.Fatalf("MatVec(%v, %v): got %v, %v; want %v, <nil>", m, v, got, err, want)
+	}
+	for i := range want {
+		if !Equal(got[i], want[i]) {
+			t.Errorf("MatVec(%v, %v)[%v]: got %v, want %v", m, v, i, got[i], want[i])
+		}
+	}
+
+	// Every product of a matrix and a vector of values sampled
+	// from m and v lies in the corresponding element of the result.
+	r := rand.New(rand.NewSource(1))
+	for k := 0; k < 100; k++ {
+		var p [2][2]float64
+		var q [2]float64
+		for i := range p {
+			for j := range p[i] {
+				p[i][j] = samples(r, m[i][j], 1)[0]
+			}
+			q[i] = samples(r, v[i], 1)[0]
+		}
+		for i := range p {
+			sum := new(big.Float).SetPrec(2200)
+			for j := range q {
+				sum.Add(sum, new(big.Float).SetPrec(2200).Mul(big.NewFloat(p[i][j]), big.NewFloat(q[j])))
+			}
+			lo, hi := got[i].BigFloats()
+			if lo.Cmp(sum) > 0 || hi.Cmp(sum) < 0 {
+				t.Errorf("MatVec(%v, %v)[%v]: got %v, which does not enclose %v", m, v, i, got[i], sum)
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		m Matrix
+		v Vector
+	}{
+		{Matrix{{inp1, inp1}, {inp1}}, Vector{inp1, inp1}},
+		{Matrix{{inp1, inp1}}, Vector{inp1}},
+	} {
+		if got, err := MatVec(test.m, test.v); got != nil || err != ErrLength {
+			t.Errorf("MatVec(%v, %v): got %v, %v; want <nil>, %v", test.m, test.v, got, err, ErrLength)
+		}
+	}
+	if got, err := MatVec(nil, v); len(got) != 0 || err != nil {
+		t.Errorf("MatVec(nil, %v): got %v, %v; want [], <nil>", v, got, err)
+	}
+}
+
+func TestSolveGS(t *testing.T) {
+	// 4x + y = 1, x + 3y = 2 has the solution x = 1/11, y = 7/11.
+	a := Matrix{
+		{{4, 4, Closed}, {1, 1, Closed}},
+		{{1, 1, Closed}, {3, 3, Closed}},
+	}
+	b := Vector{{1, 1, Closed}, {2, 2, Closed}}
+	x0 := Vector{{-10, 10, Closed}, {-10, 10, Closed}}
+	x, err := SolveGS(a, b, x0, 100)
+	if err != nil {
+		t.Fatalf("SolveGS(%v, %v, %v, 100): got %v, %v; want <nil> error", a, b, x0, x, err)
+	}
+	for i, want := range []float64{1.0 / 11, 7.0 / 11} {
+		if !x[i].Contains(want) || x[i].Width() > 1e-15 {
+			t.Errorf("SolveGS(%v, %v, %v, 100)[%v]: got %v, want a tight enclosure of %v", a, b, x0, i, x[i], want)
+		}
+	}
+	if !Equal(x0[0], &Interval{-10, 10, Closed}) {
+		t.Errorf("SolveGS modified x0: got %v", x0)
+	}
+
+	// With interval coefficients, the result encloses the solution
+	// of each point system whose solution lies in x0.
+	a = Matrix{
+		{{3.9, 4.1, Closed}, {0.9, 1.1, Closed}},
+		{{0.9, 1.1, Closed}, {2.9, 3.1, Closed}},
+	}
+	b = Vector{{0.9, 1.1, Closed}, {1.9, 2.1, Closed}}
+	x, err = SolveGS(a, b, x0, 100)
+	if err != nil {
+		t.Fatalf("SolveGS(%v, %v, %v, 100): got %v, %v; want <nil> error", a, b, x0, x, err)
+	}
+	r := rand.New(rand.NewSource(1))
+	for k := 0; k < 100; k++ {
+		var p [2][2]float64
+		var q [2]float64
+		for i := range p {
+			for j := range p[i] {
+				p[i][j] = samples(r, a[i][j], 1)[0]
+			}
+			q[i] = samples(r, b[i], 1)[0]
+		}
+		det := p[0][0]*p[1][1] - p[0][1]*p[1][0]
+		for i, v := range []float64{(q[0]*p[1][1] - p[0][1]*q[1]) / det, (p[0][0]*q[1] - q[0]*p[1][0]) / det} {
+			if !x[i].ContainsWithin(v, 1e-12) {
+				t.Errorf("SolveGS(%v, %v, %v, 100)[%v]: got %v, which does not enclose %v", a, b, x0, i, x[i], v)
+			}
+		}
+	}
+
+	if x, err := SolveGS(a, b, x0, 1); err != ErrNoConvergence || len(x) != 2 {
+		t.Errorf("SolveGS(%v, %v, %v, 1): got %v, %v; want an enclosure, %v", a, b, x0, x, err, ErrNoConvergence)
+	}
+	for _, test := range []struct {
+		a    Matrix
+		b    Vector
+		x0   Vector
+		want error
+	}{
+		{Matrix{{inp1, inp1}}, Vector{inp1}, Vector{inp1}, ErrLength},
+		{Matrix{{inp1}}, Vector{inp1, inp1}, Vector{inp1}, ErrLength},
+		{Matrix{{inp1}}, Vector{inp1}, nil, ErrLength},
+		{Matrix{{inm}}, Vector{inp1}, Vector{inr}, ErrSingular},
+		{Matrix{{inp1, inz}, {inz, inn0}}, Vector{inp1, inp1}, Vector{inr, inr}, ErrSingular},
+		{Matrix{{inp1}}, Vector{inp1}, Vector{{3, 4, Closed}}, ErrNoSolution},
+	} {
+		if x, err := SolveGS(test.a, test.b, test.x0, 10); x != nil || err != test.want {
+			t.Errorf("SolveGS(%v, %v, %v, 10): got %v, %v; want <nil>, %v", test.a, test.b, test.x0, x, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package interval
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrTolerance is returned when a tolerance is not positive.
+var ErrTolerance = errors.New("nonpositive tolerance")
+
+// Minimize encloses the global minimum of a function over domain by
+// interval branch and bound. f must be an interval extension of the function:
+// f(x) must contain the function's value at every point of x.
+//
+// Minimize repeatedly bisects domain, discarding each subinterval x
+// whose lower bound f(x).Left() exceeds the least upper bound found so far
+// by evaluating f at the midpoints of subintervals, until every remaining
+// subinterval is narrower than tol. It returns minEnclosure, an interval
+// containing the minimum value, and argEnclosure, the hull of the remaining
+// subintervals, which contains every point at which the minimum is attained.
+// If the function nearly attains its minimum at several separated points,
+// argEnclosure may be much wider than tol.
+// The number of subintervals examined grows as the function flattens
+// near its minimum, in the worst case in proportion to domain's width over tol.
+//
+// Minimize returns two empty intervals and a non-nil error if domain is empty
+// (ErrEmpty) or unbounded (ErrUnbounded), if tol is NaN (ErrNaN) or not positive
+// (ErrTolerance), or if f returns an empty interval over all of domain (ErrEmpty).
+func Minimize(f func(*Interval) *Interval, domain *Interval, tol float64) (minEnclosure *Interval, argEnclosure *Interval, err error) {
+	switch {
+	case domain.IsEmpty():
+		return empty(), empty(), ErrEmpty
+	case domain.IsLeftUnbounded() || domain.IsRightUnbounded():
+		return empty(), empty(), ErrUnbounded
+	case math.IsNaN(tol):
+		return empty(), empty(), ErrNaN
+	case tol <= 0:
+		return empty(), empty(), ErrTolerance
+	}
+	type box struct{ x, fx *Interval }
+	// ub is the least upper bound of the function's value at a point found so far.
+	ub := inf
+	var work, done []box
+	push := func(x *Interval) {
+		fx := f(x)
+		if fx.IsEmpty() || fx.a > ub {
+			return
+		}
+		mid, _ := x.MidRad()
+		if fm := f(&Interval{mid, mid, Closed}); !fm.IsEmpty() {
+			ub = math.Min(ub, fm.b)
+		}
+		work = append(work, box{x, fx})
+	}
+	push(domain)
+	for len(work) > 0 {
+		b := work[len(work)-1]
+		work = work[:len(work)-1]
+		if b.fx.a > ub {
+			continue
+		}
+		lo, hi := b.x.Bisect()
+		if b.x.Width() < tol || hi.IsEmpty() {
+			done = append(done, b)
+			continue
+		}
+		push(lo)
+		push(hi)
+	}
+
+	lb := inf
+	argEnclosure = empty()
+	for _, b := range done {
+		if b.fx.a > ub {
+			continue
+		}
+		lb = math.Min(lb, b.fx.a)
+		argEnclosure = Hull(argEnclosure, b.x)
+	}
+	if argEnclosure.IsEmpty() {
+		return empty(), empty(), ErrEmpty
+	}
+	return &Interval{lb, ub, closedEnds(lb, ub)}, argEnclosure, nil
+}
@@ -0,0 +1,81 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMinimize(t *testing.T) {
+	// g(x) = x^4 - 4x^2 + x has a local minimum near 1.35
+	// and its global minimum near -1.47.
+	four := &Interval{4, 4, Closed}
+	g := func(x *Interval) *Interval {
+		x2 := Mul(x, x)
+		return Add(Sub(Mul(x2, x2), Mul(four, x2)), x)
+	}
+	gf := func(x float64) float64 { return x*x*x*x - 4*x*x + x }
+	// Find the global minimizer by Newton's method on g'(x) = 4x^3 - 8x + 1.
+	xmin := -1.5
+	for range 50 {
+		xmin -= (4*xmin*xmin*xmin - 8*xmin + 1) / (12*xmin*xmin - 8)
+	}
+	fmin := gf(xmin)
+
+	domain := &Interval{-3, 3, Closed}
+	const tol = 1e-6
+	minEnc, argEnc, err := Minimize(g, domain, tol)
+	if err != nil {
+		t.Fatalf("Minimize(g, %v, %v): got %v, %v, %v", domain, tol, minEnc, argEnc, err)
+	}
+	// The dependency problem in g's naive evaluation widens the enclosures.
+	if !minEnc.ContainsWithin(fmin, 1e-12) || minEnc.Width() > 1e-4 {
+		t.Errorf("Minimize(g, %v, %v): got minimum %v, want an enclosure of %v", domain, tol, minEnc, fmin)
+	}
+	if !argEnc.Contains(xmin) || argEnc.Width() > 1e-2 {
+		t.Errorf("Minimize(g, %v, %v): got argument %v, want an enclosure of %v", domain, tol, argEnc, xmin)
+	}
+	// The local minimum near 1.35 is discarded.
+	if argEnc.Contains(1.35) || !(minEnc.Right() < gf(1.35)) {
+		t.Errorf("Minimize(g, %v, %v): got %v, %v, which includes the local minimum", domain, tol, minEnc, argEnc)
+	}
+
+	// The mean value form tightens both enclosures.
+	eight := &Interval{8, 8, Closed}
+	dg := func(x *Interval) *Interval {
+		return Add(Sub(Mul(four, Mul(x, Mul(x, x))), Mul(eight, x)), &Interval{1, 1, Closed})
+	}
+	gm := func(x *Interval) *Interval { return Intersection(g(x), MeanValueForm(g, dg, x)) }
+	minEnc, argEnc, err = Minimize(gm, domain, tol)
+	if err != nil || !minEnc.ContainsWithin(fmin, 1e-12) || minEnc.Width() > 1e-10 || !argEnc.Contains(xmin) || argEnc.Width() > 10*tol {
+		t.Errorf("Minimize(gm, %v, %v): got %v, %v, %v; want tight enclosures of %v and %v", domain, tol, minEnc, argEnc, err, fmin, xmin)
+	}
+
+	// A function with two global minimizers yields an argument enclosure containing both.
+	sq := func(x *Interval) *Interval { return Mul(x, x) }
+	one := &Interval{1, 1, Closed}
+	h := func(x *Interval) *Interval { d := Sub(sq(x), one); return Mul(d, d) }
+	minEnc, argEnc, err = Minimize(h, domain, tol)
+	if err != nil || !minEnc.Contains(0) || !argEnc.Contains(-1) || !argEnc.Contains(1) {
+		t.Errorf("Minimize(h, %v, %v): got %v, %v, %v; want enclosures of 0 and of -1 and 1", domain, tol, minEnc, argEnc, err)
+	}
+
+	for _, test := range []struct {
+		domain *Interval
+		tol    float64
+		err    error
+	}{
+		{ine, tol, ErrEmpty},
+		{inpi, tol, ErrUnbounded},
+		{domain, math.NaN(), ErrNaN},
+		{domain, 0, ErrTolerance},
+		{domain, -1, ErrTolerance},
+	} {
+		if minEnc, argEnc, err := Minimize(g, test.domain, test.tol); !minEnc.IsEmpty() || !argEnc.IsEmpty() || err != test.err {
+			t.Errorf("Minimize(g, %v, %v): got %v, %v, %v; want %v, %v, %v", test.domain, test.tol, minEnc, argEnc, err, ine, ine, test.err)
+		}
+	}
+	nowhere := func(*Interval) *Interval { return empty() }
+	if minEnc, argEnc, err := Minimize(nowhere, domain, tol); !minEnc.IsEmpty() || !argEnc.IsEmpty() || err != ErrEmpty {
+		t.Errorf("Minimize(nowhere, %v, %v): got %v, %v, %v; want %v, %v, %v", domain, tol, minEnc, argEnc, err, ine, ine, ErrEmpty)
+	}
+}
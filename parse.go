@@ -0,0 +1,266 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrSyntax is returned when Parse or ParseExpr is called
+// with a string that is not of the expected form.
+var ErrSyntax = errors.New("invalid syntax")
+
+// Parse returns the interval represented by s, which must be of the form
+// produced by String: a left bracket or parenthesis, the left endpoint,
+// a comma, the right endpoint, and a right bracket or parenthesis.
+// Endpoints are parsed by strconv.ParseFloat, so +Inf and -Inf are accepted.
+// Parse returns an empty interval and a non-nil error if s is malformed
+// or under the same conditions as New.
+func Parse(s string) (*Interval, error) {
+	s = strings.TrimSpace(s)
+	in, n, err := parseLiteral(s)
+	switch {
+	case n < 0 || n != len(s):
+		return empty(), fmt.Errorf("%w: %q", ErrSyntax, s)
+	case err != nil:
+		return empty(), err
+	}
+	return in, nil
+}
+
+// parseLiteral parses an interval at the beginning of s
+// and returns it with the number of bytes consumed.
+// If s does not begin with an interval literal, n is negative.
+func parseLiteral(s string) (in *Interval, n int, err error) {
+	x, y, ends, n := splitLiteral(s)
+	if n < 0 {
+		return empty(), -1, nil
+	}
+	in, err = New(x, y, ends)
+	return in, n, err
+}
+
+// splitLiteral returns the endpoints and Ends of the interval literal
+// at the beginning of s and the number of bytes it occupies,
+// without checking them as New does.
+// If s does not begin with an interval literal, n is negative.
+func splitLiteral(s string) (x, y float64, ends Ends, n int) {
+	if len(s) == 0 || s[0] != '[' && s[0] != '(' {
+		return 0, 0, Open, -1
+	}
+	n = strings.IndexAny(s, "])")
+	if n < 0 {
+		return 0, 0, Open, -1
+	}
+	a, b, ok := strings.Cut(s[1:n], ",")
+	if !ok {
+		return 0, 0, Open, -1
+	}
+	x, errx := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	y, erry := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if errx != nil || erry != nil {
+		return 0, 0, Open, -1
+	}
+	return x, y, EndsFromBools(s[0] == '[', s[n] == ']'), n + 1
+}
+
+// Scan implements fmt.Scanner for the verbs %v and %s.
+// It reads an interval literal of the form accepted by Parse,
+// after skipping any leading space.
+// A literal whose endpoints are equal and not both closed,
+// such as the form (0, 0) that String produces for an empty interval,
+// sets in to the empty interval.
+// If Scan returns a non-nil error, it leaves in unchanged.
+func (in *Interval) Scan(state fmt.ScanState, verb rune) error {
+	if verb != 'v' && verb != 's' {
+		return fmt.Errorf("interval: bad verb %%%c for Interval", verb)
+	}
+	state.SkipSpace()
+	var sb strings.Builder
+	for {
+		r, _, err := state.ReadRune()
+		if err != nil {
+			break
+		}
+		sb.WriteRune(r)
+		if r == ']' || r == ')' {
+			break
+		}
+	}
+	s := sb.String()
+	x, y, ends, n := splitLiteral(s)
+	if n != len(s) {
+		return fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	if x == y && ends != Closed {
+		*in = Interval{}
+		return nil
+	}
+	p, err := New(x, y, ends)
+	if err != nil {
+		return err
+	}
+	*in = *p
+	return nil
+}
+
+// ParseExpr evaluates an arithmetic expression over interval literals
+// of the form accepted by Parse and returns the resulting interval.
+// An expression may also contain numbers, which denote degenerate intervals,
+// the binary operators +, -, *, and /, unary negation, and parentheses.
+// Multiplication and division take precedence over addition and subtraction,
+// and operators of equal precedence are evaluated from left to right.
+//
+// ParseExpr returns an empty interval and a non-nil error if s is malformed,
+// if a literal is invalid, or if an expression is divided by [0, 0].
+// A quotient that is a union of disjoint intervals is replaced by
+// its enclosure (-inf, +inf), as described for Div.
+func ParseExpr(s string) (*Interval, error) {
+	p := &exprParser{s: s}
+	in, err := p.expr()
+	if err != nil {
+		return empty(), err
+	}
+	if p.skip(); p.pos != len(p.s) {
+		return empty(), p.errorf("unexpected %q", p.s[p.pos])
+	}
+	return in, nil
+}
+
+// An exprParser holds the state of ParseExpr.
+type exprParser struct {
+	s   string
+	pos int
+}
+
+func (p *exprParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at offset %v: %v", ErrSyntax, p.pos, fmt.Sprintf(format, args...))
+}
+
+// skip advances past any spaces.
+func (p *exprParser) skip() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\n\r", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// next returns the next non-space byte, or 0 at the end of the input.
+func (p *exprParser) next() byte {
+	if p.skip(); p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+	return 0
+}
+
+// expr parses a sum or difference of terms.
+func (p *exprParser) expr() (*Interval, error) {
+	x, err := p.term()
+	for err == nil {
+		var y *Interval
+		switch p.next() {
+		case '+':
+			p.pos++
+			if y, err = p.term(); err == nil {
+				x = Add(x, y)
+			}
+		case '-':
+			p.pos++
+			if y, err = p.term(); err == nil {
+				x = Sub(x, y)
+			}
+		default:
+			return x, nil
+		}
+	}
+	return nil, err
+}
+
+// term parses a product or quotient of factors.
+func (p *exprParser) term() (*Interval, error) {
+	x, err := p.factor()
+	for err == nil {
+		var y *Interval
+		switch p.next() {
+		case '*':
+			p.pos++
+			if y, err = p.factor(); err == nil {
+				x = Mul(x, y)
+			}
+		case '/':
+			p.pos++
+			if y, err = p.factor(); err == nil {
+				if x, err = Div(x, y); errors.Is(err, ErrDisjointUnion) {
+					err = nil
+				}
+			}
+		default:
+			return x, nil
+		}
+	}
+	return nil, err
+}
+
+// factor parses a negation, an interval literal,
+// a parenthesized expression, or a number.
+func (p *exprParser) factor() (*Interval, error) {
+	switch c := p.next(); c {
+	case 0:
+		return nil, p.errorf("unexpected end of expression")
+	case '-':
+		p.pos++
+		x, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		return x.Neg(), nil
+	case '[', '(':
+		in, n, err := parseLiteral(p.s[p.pos:])
+		if n >= 0 {
+			if err != nil {
+				return nil, fmt.Errorf("%v: %w", p.s[p.pos:p.pos+n], err)
+			}
+			p.pos += n
+			return in, nil
+		}
+		if c == '[' {
+			return nil, p.errorf("invalid interval literal")
+		}
+		p.pos++
+		x, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ')' {
+			return nil, p.errorf("missing )")
+		}
+		p.pos++
+		return x, nil
+	}
+	n := numberLen(p.s[p.pos:])
+	x, err := strconv.ParseFloat(p.s[p.pos:p.pos+n], 64)
+	if err != nil {
+		return nil, p.errorf("unexpected %q", p.s[p.pos])
+	}
+	p.pos += n
+	return NewSingle(x)
+}
+
+// numberLen returns the length of the decimal number at the beginning of s.
+func numberLen(s string) int {
+	var i int
+	digits := func() {
+		for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+			i++
+		}
+	}
+	digits()
+	if i > 0 && i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		digits()
+	}
+	return i
+}
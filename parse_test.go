@@ -0,0 +1,123 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, test := range []struct {
+		s   string
+		in  *Interval
+		err error
+	}{
+		{"[0, 1]", &Interval{0, 1, Closed}, nil},
+		{"(0, 1]", &Interval{0, 1, RightClosed}, nil},
+		{"[0, 1)", &Interval{0, 1, LeftClosed}, nil},
+		{" (-2.5,1e3) ", &Interval{-2.5, 1000, Open}, nil},
+		{"(-Inf, 3]", &Interval{neginf, 3, RightClosed}, nil},
+		{"(-Inf, +Inf)", &Interval{neginf, inf, Open}, nil},
+		{"[-Inf, 3]", empty(), ErrClosedInf},
+		{"[2, 1]", empty(), ErrEmpty},
+		{"(0, 0)", empty(), ErrEmpty},
+		{"", empty(), ErrSyntax},
+		{"[0, 1", empty(), ErrSyntax},
+		{"[0; 1]", empty(), ErrSyntax},
+		{"[0, x]", empty(), ErrSyntax},
+		{"[0, 1]]", empty(), ErrSyntax},
+		{"1", empty(), ErrSyntax},
+	} {
+		if got, err := Parse(test.s); !Equal(got, test.in) || !errors.Is(err, test.err) {
+			t.Errorf("Parse(%q): got %v, %v; want %v, %v", test.s, got, err, test.in, test.err)
+		}
+	}
+	for _, in := range []*Interval{
+		{0, 0, Closed},
+		{-1.5, 2, LeftClosed},
+		{neginf, 3, RightClosed},
+		{1.0 / 3, inf, Open},
+	} {
+		if got, err := Parse(in.String()); !Equal(got, in) || err != nil {
+			t.Errorf("Parse(%q): got %v, %v; want %v, <nil>", in.String(), got, err, in)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	for _, in := range []*Interval{
+		{0, 1, Closed},
+		{0, 1, LeftClosed},
+		{0, 1, RightClosed},
+		{0, 1, Open},
+		{-3, -3, Closed},
+		{-1.5, 1.0 / 3, Closed},
+		{neginf, 3, RightClosed},
+		{2, inf, LeftClosed},
+		{neginf, inf, Open},
+		empty(),
+	} {
+		var got Interval
+		if _, err := fmt.Sscanf(in.String(), "%v", &got); !Equal(&got, in) || err != nil {
+			t.Errorf("Sscanf(%q, %%v): got %v, %v; want %v, <nil>", in.String(), &got, err, in)
+		}
+	}
+
+	x, y := &Interval{1, 2, Closed}, &Interval{1, 2, Closed}
+	if n, err := fmt.Sscan("  (0, 0)\n[-Inf, 1)", x, y); n != 1 || !errors.Is(err, ErrClosedInf) || !x.IsEmpty() || !Equal(y, &Interval{1, 2, Closed}) {
+		t.Errorf("Sscan: got %v, %v, %v, %v; want 1, %v, %v, %v", n, err, x, y, ErrClosedInf, empty(), &Interval{1, 2, Closed})
+	}
+	var z Interval
+	if n, err := fmt.Sscanf("x in [0, 1) ok", "x in %v ok", &z); n != 1 || err != nil || !Equal(&z, &Interval{0, 1, LeftClosed}) {
+		t.Errorf("Sscanf: got %v, %v, %v; want 1, <nil>, %v", n, err, &z, &Interval{0, 1, LeftClosed})
+	}
+
+	for _, test := range []struct {
+		s, format string
+		err       error
+	}{
+		{"[2, 1]", "%v", ErrEmpty},
+		{"[0, 1", "%v", ErrSyntax},
+		{"[0; 1]", "%v", ErrSyntax},
+		{"1", "%v", ErrSyntax},
+		{"[0, 1]", "%d", nil},
+	} {
+		var in Interval
+		if _, err := fmt.Sscanf(test.s, test.format, &in); err == nil || test.err != nil && !errors.Is(err, test.err) {
+			t.Errorf("Sscanf(%q, %v): got %v, want %v", test.s, test.format, err, test.err)
+		}
+	}
+}
+
+func TestParseExpr(t *testing.T) {
+	for _, test := range []struct {
+		s   string
+		in  *Interval
+		err error
+	}{
+		{"[1, 2]", &Interval{1, 2, Closed}, nil},
+		{"[1,2] + [3,4] * [0,1]", &Interval{1, 6, Closed}, nil},
+		{"([1,2] + [3,4]) * [0,1]", &Interval{0, 6, Closed}, nil},
+		{"[1, 2] - [1, 2]", &Interval{-1, 1, Closed}, nil},
+		{"[1, 2] - [0, 1] - [0, 1]", &Interval{-1, 2, Closed}, nil},
+		{"[1, 2] / [2, 4]", &Interval{0.25, 1, Closed}, nil},
+		{"-[1, 2] * 3", &Interval{-6, -3, Closed}, nil},
+		{"2 * (0, 1] + 1e1", &Interval{10, 12, RightClosed}, nil},
+		{"((1, 2))", &Interval{1, 2, Open}, nil},
+		{"[1, 2] / [-1, 1]", &Interval{neginf, inf, Open}, nil},
+		{"[1, 2] / [0, 0]", empty(), ErrDivByZero},
+		{"[1, 2] / ([1, 1] - 1)", empty(), ErrDivByZero},
+		{"[2, 1]", empty(), ErrEmpty},
+		{"", empty(), ErrSyntax},
+		{"[1, 2] +", empty(), ErrSyntax},
+		{"([1, 2]", empty(), ErrSyntax},
+		{"[1, 2])", empty(), ErrSyntax},
+		{"[1, 2] [3, 4]", empty(), ErrSyntax},
+		{"[1 2]", empty(), ErrSyntax},
+		{"x", empty(), ErrSyntax},
+	} {
+		if got, err := ParseExpr(test.s); !Equal(got, test.in) || !errors.Is(err, test.err) {
+			t.Errorf("ParseExpr(%q): got %v, %v; want %v, %v", test.s, got, err, test.in, test.err)
+		}
+	}
+}
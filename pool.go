@@ -0,0 +1,41 @@
+package interval
+
+import "sync"
+
+// pool holds *Interval values returned by Release for reuse by Pooled.
+var pool = sync.Pool{New: func() any { return new(Interval) }}
+
+// Pooled returns a pointer to a copy of in, reusing an *Interval
+// previously passed to Release if one is available.
+// Combined with the value methods, it lets a computation producing
+// many short-lived intermediate intervals recycle their storage:
+//
+//	z := Pooled(x.Mul(*y))
+//	// use z
+//	Release(z)
+//
+// An *Interval returned by Pooled is indistinguishable from one
+// returned by any other function in this package.
+func Pooled(in Interval) *Interval {
+	p := pool.Get().(*Interval)
+	*p = in
+	return p
+}
+
+// Release returns in to the pool used by Pooled and resets it to the empty interval.
+// Release(nil) does nothing.
+//
+// After calling Release, the caller must not use in, nor any other copy of
+// the pointer: a later call to Pooled may overwrite the Interval it points to.
+// In particular, do not release an interval that is still held elsewhere,
+// such as an element of an IntervalSet or a result that a caller has stored.
+// The pointer-based functions in this package may return one of their
+// arguments' endpoints but never the argument pointer itself, so releasing
+// an argument after use does not affect a result computed from it.
+func Release(in *Interval) {
+	if in == nil {
+		return
+	}
+	*in = Interval{}
+	pool.Put(in)
+}
@@ -0,0 +1,62 @@
+package interval
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPooled(t *testing.T) {
+	ins := []*Interval{ine, inz, inp0, inp1, inm, inn0, inn1, inpi, inni, inr}
+	r := rand.New(rand.NewSource(1))
+	for range 100 {
+		ins = append(ins, randomIntInterval(r))
+	}
+	for _, x := range ins {
+		p := Pooled(*x)
+		if !Identical(p, x) || p == x {
+			t.Errorf("Pooled(%v): got %p %v, want a copy", x, p, p)
+		}
+		for _, y := range ins {
+			q := Pooled(*y)
+			for _, op := range []struct {
+				name      string
+				got, want *Interval
+			}{
+				{"Add", Add(p, q), Add(x, y)},
+				{"Mul", Mul(p, q), Mul(x, y)},
+				{"Intersection", Intersection(p, q), Intersection(x, y)},
+				{"Union", Union(p, q), Union(x, y)},
+			} {
+				if !Identical(op.got, op.want) {
+					t.Errorf("%v(%v, %v) on pooled intervals: got %v, want %v", op.name, x, y, op.got, op.want)
+				}
+			}
+			Release(q)
+		}
+		Release(p)
+		if !Identical(p, ine) {
+			t.Errorf("Release(%v): got %v, want %v", x, p, ine)
+		}
+	}
+	Release(nil)
+}
+
+func BenchmarkPooled(b *testing.B) {
+	x, y := Interval{-2, -1, Closed}, Interval{3, 4, LeftClosed}
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			z := Mul(&x, &y)
+			benchResult = Add(z, &x)
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			z := Pooled(x.Mul(y))
+			w := Pooled(z.Add(x))
+			Release(z)
+			Release(w)
+		}
+	})
+}
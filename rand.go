@@ -0,0 +1,41 @@
+package interval
+
+import (
+	"math"
+	"math/rand"
+)
+
+// RandomInterval returns a random non-empty interval,
+// suitable for property-based testing.
+// Its endpoints are small integers, normally distributed finite values,
+// or infinities, and it may be degenerate or unbounded.
+// Every interval returned by RandomInterval is a valid result of New.
+func RandomInterval(r *rand.Rand) *Interval {
+	a, b := neginf, inf
+	if r.Intn(8) != 0 {
+		a = randomFloat(r)
+	}
+	switch n := r.Intn(8); {
+	case n == 0:
+		// unbounded above
+	case n == 1 && a != neginf:
+		b = a
+	case a == neginf:
+		b = randomFloat(r)
+	default:
+		b = a + math.Abs(randomFloat(r))
+	}
+	if a == b {
+		return &Interval{a, b, Closed}
+	}
+	return &Interval{a, b, Ends(r.Intn(4)) & closedEnds(a, b)}
+}
+
+// randomFloat returns either a random integer in [-10, 10]
+// or a normally distributed value with standard deviation 10.
+func randomFloat(r *rand.Rand) float64 {
+	if r.Intn(2) == 0 {
+		return float64(r.Intn(21) - 10)
+	}
+	return 10 * r.NormFloat64()
+}
@@ -0,0 +1,26 @@
+package interval
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandomInterval(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var single, unbounded int
+	for i := 0; i < 10000; i++ {
+		in := RandomInterval(r)
+		if got, err := New(in.a, in.b, in.ends); !Equal(got, in) || err != nil {
+			t.Fatalf("RandomInterval: got invalid interval %v: %v", in, err)
+		}
+		if in.IsSingle() {
+			single++
+		}
+		if in.a == neginf || in.b == inf {
+			unbounded++
+		}
+	}
+	if single == 0 || unbounded == 0 {
+		t.Errorf("RandomInterval: got %v degenerate and %v unbounded intervals, want some of each", single, unbounded)
+	}
+}
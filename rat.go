@@ -0,0 +1,175 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// ErrUnbounded is returned when a function that requires a bounded interval
+// is given or would produce an unbounded one. In particular, it is returned
+// when RatInterval.Div is called with a divisor other than [0, 0]
+// that contains 0 or has 0 as an endpoint, since the quotient
+// is unbounded and cannot be represented. Combine returns it for an
+// infinite weight, and NewWrap for an infinite endpoint.
+var ErrUnbounded = errors.New("unbounded interval")
+
+// A RatInterval is a bounded interval with exact rational endpoints.
+// Arithmetic on RatIntervals is exact, at the cost of speed and memory.
+// The RatInterval type's zero value is not usable; use NewRat or ToRat.
+type RatInterval struct {
+	a, b *big.Rat
+	ends Ends
+}
+
+// NewRat returns a pointer to a RatInterval with endpoints x and y.
+// Ends describes whether the endpoints are open or closed.
+// NewRat returns an empty interval and ErrEmpty
+// if the interval would be empty.
+func NewRat(x, y *big.Rat, ends Ends) (*RatInterval, error) {
+	in := &RatInterval{new(big.Rat).Set(x), new(big.Rat).Set(y), ends}
+	if in.IsEmpty() {
+		return emptyRat(), ErrEmpty
+	}
+	return in, nil
+}
+
+// ToRat returns in as a RatInterval, and reports whether
+// the conversion succeeded, which it does if in's endpoints are finite.
+// An empty interval converts to an empty RatInterval.
+func (in *Interval) ToRat() (*RatInterval, bool) {
+	if in.IsEmpty() {
+		return emptyRat(), true
+	}
+	if math.IsInf(in.a, 0) || math.IsInf(in.b, 0) {
+		return nil, false
+	}
+	return &RatInterval{new(big.Rat).SetFloat64(in.a), new(big.Rat).SetFloat64(in.b), in.ends}, true
+}
+
+// emptyRat returns the empty interval (0, 0).
+func emptyRat() *RatInterval { return &RatInterval{new(big.Rat), new(big.Rat), Open} }
+
+// Left returns a copy of in's left endpoint.
+func (in *RatInterval) Left() *big.Rat { return new(big.Rat).Set(in.a) }
+
+// Right returns a copy of in's right endpoint.
+func (in *RatInterval) Right() *big.Rat { return new(big.Rat).Set(in.b) }
+
+// Ends returns in's Ends.
+func (in *RatInterval) Ends() Ends { return in.ends }
+
+// IsEmpty reports whether in is an empty interval.
+func (in *RatInterval) IsEmpty() bool {
+	c := in.a.Cmp(in.b)
+	return c > 0 || c == 0 && in.ends != Closed
+}
+
+// String returns a string representation of in,
+// with endpoints formatted as fractions.
+func (in *RatInterval) String() string {
+	l, r := (&Interval{ends: in.ends}).brackets()
+	return fmt.Sprintf("%v%v, %v%v", l, in.a.RatString(), in.b.RatString(), r)
+}
+
+// Neg returns the additive inverse of in.
+func (in *RatInterval) Neg() *RatInterval {
+	if in.IsEmpty() {
+		return emptyRat()
+	}
+	return &RatInterval{new(big.Rat).Neg(in.b), new(big.Rat).Neg(in.a), in.ends.flip()}
+}
+
+// Add returns the sum in+y.
+func (in *RatInterval) Add(y *RatInterval) *RatInterval {
+	if in.IsEmpty() || y.IsEmpty() {
+		return emptyRat()
+	}
+	return &RatInterval{new(big.Rat).Add(in.a, y.a), new(big.Rat).Add(in.b, y.b), in.ends & y.ends}
+}
+
+// Sub returns the difference in-y.
+func (in *RatInterval) Sub(y *RatInterval) *RatInterval { return in.Add(y.Neg()) }
+
+// Mul returns the product in*y.
+func (in *RatInterval) Mul(y *RatInterval) *RatInterval {
+	if in.IsEmpty() || y.IsEmpty() {
+		return emptyRat()
+	}
+	var lo, hi ratEnd
+	for i, ie := range in.endpoints() {
+		for j, ye := range y.endpoints() {
+			// A product of endpoints is attained if both endpoints are closed,
+			// or if either is a closed endpoint at 0.
+			p := ratEnd{
+				new(big.Rat).Mul(ie.v, ye.v),
+				ie.closed && ye.closed || ie.closedZero() || ye.closedZero(),
+			}
+			if i == 0 && j == 0 {
+				lo, hi = p, p
+				continue
+			}
+			switch c := p.v.Cmp(lo.v); {
+			case c < 0:
+				lo = p
+			case c == 0:
+				lo.closed = lo.closed || p.closed
+			}
+			switch c := p.v.Cmp(hi.v); {
+			case c > 0:
+				hi = p
+			case c == 0:
+				hi.closed = hi.closed || p.closed
+			}
+		}
+	}
+	var e Ends
+	if lo.closed {
+		e |= leftEndMask
+	}
+	if hi.closed {
+		e |= rightEndMask
+	}
+	return &RatInterval{lo.v, hi.v, e}
+}
+
+// A ratEnd is an endpoint of a RatInterval.
+type ratEnd struct {
+	v      *big.Rat
+	closed bool
+}
+
+// closedZero reports whether e is a closed endpoint at 0.
+func (e ratEnd) closedZero() bool { return e.closed && e.v.Sign() == 0 }
+
+// endpoints returns in's left and right endpoints.
+func (in *RatInterval) endpoints() [2]ratEnd {
+	return [2]ratEnd{{in.a, in.ends&leftEndMask != 0}, {in.b, in.ends&rightEndMask != 0}}
+}
+
+// Div returns the quotient in/y.
+//
+// Special cases are:
+//
+//	Div(empty, y) = Div(in, empty) = empty, nil
+//	Div(in, [0, 0]) = empty, ErrDivByZero
+//	Div(in, y) = empty, ErrUnbounded if y contains 0 or has 0 as an endpoint
+//
+// A non-nil error is an *IntervalError that records in and y.
+func (in *RatInterval) Div(y *RatInterval) (*RatInterval, error) {
+	var err error
+	switch {
+	case in.IsEmpty() || y.IsEmpty():
+		return emptyRat(), nil
+	case y.a.Sign() == 0 && y.b.Sign() == 0:
+		err = ErrDivByZero
+	case y.a.Sign() <= 0 && y.b.Sign() >= 0:
+		err = ErrUnbounded
+	}
+	if err != nil {
+		return emptyRat(), &IntervalError{in.String() + " / " + y.String(), err}
+	}
+	inv := &RatInterval{new(big.Rat).Inv(y.b), new(big.Rat).Inv(y.a), y.ends.flip()}
+	return in.Mul(inv), nil
+}
@@ -0,0 +1,89 @@
+package interval
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func rat(a, b int64, ends Ends) *RatInterval {
+	return &RatInterval{big.NewRat(a, 1), big.NewRat(b, 1), ends}
+}
+
+func equalRat(x, y *RatInterval) bool {
+	if x.IsEmpty() {
+		return y.IsEmpty()
+	}
+	return x.a.Cmp(y.a) == 0 && x.b.Cmp(y.b) == 0 && x.ends == y.ends
+}
+
+func TestNewRat(t *testing.T) {
+	if _, err := NewRat(big.NewRat(2, 1), big.NewRat(1, 1), Closed); !errors.Is(err, ErrEmpty) {
+		t.Errorf("NewRat(2, 1, Closed): got %v, want %v", err, ErrEmpty)
+	}
+	if in, err := NewRat(big.NewRat(1, 3), big.NewRat(1, 2), LeftClosed); err != nil || in.String() != "[1/3, 1/2)" {
+		t.Errorf("NewRat(1/3, 1/2, LeftClosed): got %v, %v; want [1/3, 1/2), <nil>", in, err)
+	}
+}
+
+func TestToRat(t *testing.T) {
+	for _, test := range []struct {
+		in   *Interval
+		want *RatInterval
+		ok   bool
+	}{
+		{empty(), emptyRat(), true},
+		{&Interval{1, 2, LeftClosed}, rat(1, 2, LeftClosed), true},
+		{&Interval{0.5, 0.5, Closed}, &RatInterval{big.NewRat(1, 2), big.NewRat(1, 2), Closed}, true},
+		{&Interval{0, inf, LeftClosed}, nil, false},
+		{&Interval{neginf, 0, Open}, nil, false},
+	} {
+		got, ok := test.in.ToRat()
+		if ok != test.ok || ok && !equalRat(got, test.want) {
+			t.Errorf("%v.ToRat(): got %v, %v; want %v, %v", test.in, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestRatArith(t *testing.T) {
+	for _, test := range []struct {
+		x, y, add, sub, mul *RatInterval
+	}{
+		{rat(1, 2, Closed), rat(3, 4, Closed), rat(4, 6, Closed), rat(-3, -1, Closed), rat(3, 8, Closed)},
+		{rat(-2, 4, Closed), rat(-3, 1, Open), rat(-5, 5, Open), rat(-3, 7, Open), rat(-12, 6, Open)},
+		{rat(0, 1, Closed), rat(2, 3, Open), rat(2, 4, Open), rat(-3, -1, Open), rat(0, 3, LeftClosed)},
+		{rat(-2, 2, LeftClosed), rat(-2, 2, LeftClosed), rat(-4, 4, LeftClosed), rat(-4, 4, Open), rat(-4, 4, RightClosed)},
+		{emptyRat(), rat(1, 2, Closed), emptyRat(), emptyRat(), emptyRat()},
+	} {
+		if got := test.x.Add(test.y); !equalRat(got, test.add) {
+			t.Errorf("%v.Add(%v): got %v, want %v", test.x, test.y, got, test.add)
+		}
+		if got := test.x.Sub(test.y); !equalRat(got, test.sub) {
+			t.Errorf("%v.Sub(%v): got %v, want %v", test.x, test.y, got, test.sub)
+		}
+		if got := test.x.Mul(test.y); !equalRat(got, test.mul) {
+			t.Errorf("%v.Mul(%v): got %v, want %v", test.x, test.y, got, test.mul)
+		}
+	}
+}
+
+func TestRatDiv(t *testing.T) {
+	third := big.NewRat(1, 3)
+	for _, test := range []struct {
+		x, y, want *RatInterval
+		err        error
+	}{
+		{rat(1, 1, Closed), rat(3, 3, Closed), &RatInterval{third, third, Closed}, nil},
+		{rat(1, 2, Closed), rat(-4, -2, LeftClosed), &RatInterval{big.NewRat(-1, 1), big.NewRat(-1, 4), RightClosed}, nil},
+		{rat(-1, 2, Closed), rat(3, 6, Closed), &RatInterval{new(big.Rat).Neg(third), big.NewRat(2, 3), Closed}, nil},
+		{rat(0, 1, Closed), rat(2, 4, Open), &RatInterval{new(big.Rat), big.NewRat(1, 2), LeftClosed}, nil},
+		{rat(1, 2, Closed), rat(0, 0, Closed), emptyRat(), ErrDivByZero},
+		{rat(1, 2, Closed), rat(-1, 1, Closed), emptyRat(), ErrUnbounded},
+		{rat(1, 2, Closed), rat(0, 1, RightClosed), emptyRat(), ErrUnbounded},
+		{emptyRat(), rat(0, 0, Closed), emptyRat(), nil},
+	} {
+		if got, err := test.x.Div(test.y); !equalRat(got, test.want) || !errors.Is(err, test.err) {
+			t.Errorf("%v.Div(%v): got %v, %v; want %v, %v", test.x, test.y, got, err, test.want, test.err)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package interval
+
+// QuadraticRoots returns a set containing every real root of ax² + bx + c = 0
+// for every choice of coefficients in a, b, and c.
+// The roots are enclosed by the quadratic formula (-b ± √(b² - 4ac)) / 2a,
+// where the square root is taken over the non-negative part of the discriminant.
+// If a contains 0, the division may yield unbounded or disjoint intervals,
+// which are included in the result; if a is [0, 0], the equation is
+// linear and its root is -c/b.
+//
+// QuadraticRoots returns an empty set if the discriminant is negative
+// for every choice of coefficients, or if a, b, or c is empty.
+// It returns a nil set and ErrDivByZero if a and b are both [0, 0].
+func QuadraticRoots(a, b, c *Interval) (IntervalSet, error) {
+	switch {
+	case a.IsEmpty() || b.IsEmpty() || c.IsEmpty():
+		return nil, nil
+	case a.IsZero():
+		return DivFull(c.Neg(), b)
+	}
+	// Abs(b) squared, unlike Mul(b, b), does not widen a b that contains 0.
+	absb := Abs(b)
+	four := &Interval{4, 4, Closed}
+	disc := Sub(Mul(absb, absb), Mul(four, Mul(a, c)))
+	sq := Sqrt(disc)
+	if sq.IsEmpty() {
+		return nil, nil
+	}
+	twoA := Mul(&Interval{2, 2, Closed}, a)
+	var ins []*Interval
+	for _, num := range []*Interval{Sub(b.Neg(), sq), Add(b.Neg(), sq)} {
+		s, err := DivFull(num, twoA)
+		if err != nil {
+			return nil, err
+		}
+		ins = append(ins, s...)
+	}
+	return setOf(ins), nil
+}
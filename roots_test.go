@@ -0,0 +1,79 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestQuadraticRoots(t *testing.T) {
+	one := &Interval{1, 1, Closed}
+	for _, test := range []struct {
+		a, b, c *Interval
+		roots   []float64
+	}{
+		// x² - 3x + 2 = (x - 1)(x - 2)
+		{one, &Interval{-3, -3, Closed}, &Interval{2, 2, Closed}, []float64{1, 2}},
+		// x² - 2x + 1 = (x - 1)²
+		{one, &Interval{-2, -2, Closed}, one, []float64{1}},
+		// 2x - 4 = 0
+		{zero(), &Interval{2, 2, Closed}, &Interval{-4, -4, Closed}, []float64{2}},
+	} {
+		s, err := QuadraticRoots(test.a, test.b, test.c)
+		if len(s) != len(test.roots) || err != nil {
+			t.Errorf("QuadraticRoots(%v, %v, %v): got %v, %v; want enclosures of %v, <nil>", test.a, test.b, test.c, s, err, test.roots)
+			continue
+		}
+		for i, x := range test.roots {
+			if !s[i].Contains(x) || s[i].Width() > 1e-15 {
+				t.Errorf("QuadraticRoots(%v, %v, %v)[%v]: got %v, want a tight enclosure of %v", test.a, test.b, test.c, i, s[i], x)
+			}
+		}
+	}
+
+	// With interval coefficients bracketing x² - 3x + 2, the result
+	// encloses the roots of each sampled equation in two components.
+	r := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ a, b, c *Interval }{
+		{&Interval{0.99, 1.01, Closed}, &Interval{-3.01, -2.99, Closed}, &Interval{1.99, 2.01, Closed}},
+		{&Interval{-0.01, 0.01, Closed}, &Interval{1, 2, Closed}, &Interval{-1, 1, Closed}},
+	} {
+		s, err := QuadraticRoots(test.a, test.b, test.c)
+		if err != nil {
+			t.Errorf("QuadraticRoots(%v, %v, %v): got %v, %v; want <nil> error", test.a, test.b, test.c, s, err)
+			continue
+		}
+		for range 100 {
+			a, b, c := samples(r, test.a, 1)[0], samples(r, test.b, 1)[0], samples(r, test.c, 1)[0]
+			if a == 0 {
+				continue
+			}
+			d := math.Sqrt(b*b - 4*a*c)
+			for _, x := range []float64{(-b - d) / (2 * a), (-b + d) / (2 * a)} {
+				if !s.Contains(x) && !s.Contains(math.Nextafter(x, neginf)) && !s.Contains(math.Nextafter(x, inf)) {
+					t.Errorf("QuadraticRoots(%v, %v, %v): got %v, which does not enclose %v", test.a, test.b, test.c, s, x)
+				}
+			}
+		}
+	}
+	s, _ := QuadraticRoots(&Interval{0.99, 1.01, Closed}, &Interval{-3.01, -2.99, Closed}, &Interval{1.99, 2.01, Closed})
+	if len(s) != 2 || !s[0].Contains(1) || !s[1].Contains(2) || s[0].Width() > 0.2 || s[1].Width() > 0.2 {
+		t.Errorf("QuadraticRoots: got %v, want narrow enclosures of 1 and 2", s)
+	}
+
+	for _, test := range []struct {
+		a, b, c *Interval
+		err     error
+	}{
+		{one, zero(), one, nil},
+		{&Interval{1, 2, Closed}, &Interval{-1, 1, Closed}, &Interval{1, 2, Closed}, nil},
+		{ine, one, one, nil},
+		{one, ine, one, nil},
+		{zero(), zero(), one, ErrDivByZero},
+	} {
+		if s, err := QuadraticRoots(test.a, test.b, test.c); len(s) != 0 || !errors.Is(err, test.err) {
+			t.Errorf("QuadraticRoots(%v, %v, %v): got %v, %v; want [], %v", test.a, test.b, test.c, s, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package interval
+
+import (
+	"slices"
+	"sort"
+)
+
+// An IntervalSet is a union of disjoint non-empty intervals
+// in increasing order.
+type IntervalSet []*Interval
+
+// Contains reports whether s contains x.
+func (s IntervalSet) Contains(x float64) bool {
+	// Adjacent components may share an endpoint, such as [0, 1) and [1, 2],
+	// so x may lie in the component after the first one that reaches it.
+	i := sort.Search(len(s), func(i int) bool { return s[i].b >= x })
+	return i < len(s) && s[i].Contains(x) || i+1 < len(s) && s[i+1].Contains(x)
+}
+
+// AsPredicate returns a function that reports whether s contains its argument.
+func (s IntervalSet) AsPredicate() func(float64) bool { return s.Contains }
+
+// ContainsInterval reports whether in is contained in a single component of s.
+// The empty interval is contained in every set.
+func (s IntervalSet) ContainsInterval(in *Interval) bool {
+	if in.IsEmpty() {
+		return true
+	}
+	i := sort.Search(len(s), func(i int) bool { return s[i].b >= in.a })
+	return i < len(s) && Includes(s[i], in) || i+1 < len(s) && Includes(s[i+1], in)
+}
+
+// Covers reports whether every value in in is contained in s.
+// Unlike ContainsInterval, Covers allows in to span several components of s,
+// provided that there is no gap between them.
+func (s IntervalSet) Covers(in *Interval) bool { return len(Gaps(s, in)) == 0 }
+
+// Simplify returns the intervals in ins that are not subsets of any other
+// interval in ins, in their original order. Of several equal intervals,
+// only the first is kept. Empty intervals are dropped.
+// For example, Simplify of [0, 5], [1, 2], and [3, 8] is [0, 5] and [3, 8].
+func Simplify(ins []*Interval) []*Interval {
+	var out []*Interval
+	for i, x := range ins {
+		if x.IsEmpty() {
+			continue
+		}
+		redundant := false
+		for j, y := range ins {
+			if j != i && Includes(y, x) && (j < i || !Includes(x, y)) {
+				redundant = true
+				break
+			}
+		}
+		if !redundant {
+			out = append(out, x)
+		}
+	}
+	return out
+}
+
+// Atomize returns the coarsest partition of the union of ins into disjoint
+// intervals, in increasing order, such that each interval in the partition
+// is either entirely inside or entirely outside each interval in ins.
+// For example, Atomize of [0, 3] and [1, 4] is [0, 1), [1, 3], and (3, 4].
+func Atomize(ins []*Interval) []*Interval {
+	var atoms []*Interval
+	var prev []bool
+	for _, p := range pieces(ins) {
+		in, ok := make([]bool, len(ins)), false
+		for i, x := range ins {
+			in[i] = covers(x, p)
+			ok = ok || in[i]
+		}
+		switch {
+		case !ok:
+			in = nil
+		case prev != nil && slices.Equal(in, prev):
+			last := atoms[len(atoms)-1]
+			last.b, last.ends = p.b, last.ends&leftEndMask+p.ends&rightEndMask
+		default:
+			atoms = append(atoms, p)
+		}
+		prev = in
+	}
+	return atoms
+}
+
+// setOf returns the union of ins as an IntervalSet,
+// merging intervals that overlap or share an endpoint.
+func setOf(ins []*Interval) IntervalSet {
+	var s IntervalSet
+	for _, in := range slices.SortedFunc(slices.Values(ins), Cmp) {
+		if in.IsEmpty() {
+			continue
+		}
+		if n := len(s); n > 0 {
+			if u, ok := UnionOK(s[n-1], in); ok {
+				s[n-1] = u
+				continue
+			}
+		}
+		s = append(s, &Interval{in.a, in.b, in.ends})
+	}
+	return s
+}
+
+// pieces partitions the real line into the degenerate intervals
+// at each finite endpoint of ins and the open intervals between them,
+// in increasing order.
+func pieces(ins []*Interval) []*Interval {
+	var pts []float64
+	for _, in := range ins {
+		pts = append(pts, in.BoundaryPoints()...)
+	}
+	slices.Sort(pts)
+	pts = slices.Compact(pts)
+	var ps []*Interval
+	a := neginf
+	for _, p := range pts {
+		ps = append(ps, &Interval{a, p, Open}, &Interval{p, p, Closed})
+		a = p
+	}
+	return append(ps, &Interval{a, inf, Open})
+}
+
+// covers reports whether x contains p, where p is a piece
+// of a partition that contains no endpoint of x in its interior.
+func covers(x, p *Interval) bool {
+	if p.IsSingle() {
+		return x.Contains(p.a)
+	}
+	return !x.IsEmpty() && x.a <= p.a && p.b <= x.b
+}
+
+// CoverageDepth returns the number of intervals in ins that contain x.
+func CoverageDepth(ins []*Interval, x float64) int {
+	var n int
+	for _, in := range ins {
+		if in.Contains(x) {
+			n++
+		}
+	}
+	return n
+}
+
+// A DepthSegment is an interval together with
+// the number of intervals that contain it.
+type DepthSegment struct {
+	Interval *Interval
+	Depth    int
+}
+
+// DepthProfile returns the coverage depth of ins over each interval
+// in Atomize(ins), in increasing order.
+func DepthProfile(ins []*Interval) []DepthSegment {
+	atoms := Atomize(ins)
+	segs := make([]DepthSegment, len(atoms))
+	for i, a := range atoms {
+		segs[i].Interval = a
+		for _, in := range ins {
+			if Includes(in, a) {
+				segs[i].Depth++
+			}
+		}
+	}
+	return segs
+}
+
+// Gaps returns the set of values in within that are not contained
+// in any interval in ins.
+func Gaps(ins []*Interval, within *Interval) IntervalSet {
+	var s IntervalSet
+	var merge bool
+	for _, p := range pieces(append(ins[:len(ins):len(ins)], within)) {
+		if !covers(within, p) || slices.ContainsFunc(ins, func(in *Interval) bool { return covers(in, p) }) {
+			merge = false
+			continue
+		}
+		if merge {
+			last := s[len(s)-1]
+			last.b, last.ends = p.b, last.ends&leftEndMask+p.ends&rightEndMask
+		} else {
+			s = append(s, p)
+		}
+		merge = true
+	}
+	return s
+}
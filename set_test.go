@@ -0,0 +1,264 @@
+package interval
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIntervalSetContains(t *testing.T) {
+	s := IntervalSet{
+		{neginf, -5, Open},
+		{0, 1, LeftClosed},
+		{1, 2, Closed},
+		{3, 4, Open},
+		{6, 6, Closed},
+	}
+	f := s.AsPredicate()
+	for _, test := range []struct {
+		x    float64
+		want bool
+	}{
+		{-100, true},
+		{-5, false},
+		{-1, false},
+		{0, true},
+		{0.5, true},
+		{1, true},
+		{2, true},
+		{2.5, false},
+		{3, false},
+		{3.5, true},
+		{4, false},
+		{6, true},
+		{7, false},
+		{inf, false},
+	} {
+		if got := s.Contains(test.x); got != test.want {
+			t.Errorf("%v.Contains(%v): got %v, want %v", s, test.x, got, test.want)
+		}
+		if got := f(test.x); got != test.want {
+			t.Errorf("%v.AsPredicate()(%v): got %v, want %v", s, test.x, got, test.want)
+		}
+	}
+	if f := IntervalSet(nil).AsPredicate(); f(0) {
+		t.Errorf("IntervalSet(nil).AsPredicate()(0): got true, want false")
+	}
+}
+
+func TestIntervalSetContainsInterval(t *testing.T) {
+	s := IntervalSet{
+		{neginf, -5, Open},
+		{0, 1, LeftClosed},
+		{1, 2, Closed},
+		{3, 4, Open},
+		{6, 6, Closed},
+	}
+	for _, test := range []struct {
+		in               *Interval
+		contains, covers bool
+	}{
+		{empty(), true, true},
+		{&Interval{0.25, 0.75, Closed}, true, true},
+		{&Interval{0, 1, LeftClosed}, true, true},
+		{&Interval{0, 1, Closed}, false, true},
+		{&Interval{0.5, 1.5, Closed}, false, true},
+		{&Interval{0, 2, Closed}, false, true},
+		{&Interval{1.5, 3.5, Closed}, false, false},
+		{&Interval{3, 4, Open}, true, true},
+		{&Interval{3, 4, LeftClosed}, false, false},
+		{&Interval{6, 6, Closed}, true, true},
+		{&Interval{neginf, -10, RightClosed}, true, true},
+		{&Interval{neginf, 0, Open}, false, false},
+		{&Interval{5, 7, Closed}, false, false},
+	} {
+		if got := s.ContainsInterval(test.in); got != test.contains {
+			t.Errorf("%v.ContainsInterval(%v): got %v, want %v", s, test.in, got, test.contains)
+		}
+		if got := s.Covers(test.in); got != test.covers {
+			t.Errorf("%v.Covers(%v): got %v, want %v", s, test.in, got, test.covers)
+		}
+	}
+	if got := (IntervalSet{}).ContainsInterval(&Interval{0, 1, Closed}); got {
+		t.Errorf("IntervalSet{}.ContainsInterval(%v): got %v, want false", &Interval{0, 1, Closed}, got)
+	}
+}
+
+func TestSimplify(t *testing.T) {
+	for _, test := range []struct {
+		ins, want []*Interval
+	}{
+		{nil, nil},
+		{[]*Interval{empty()}, nil},
+		{[]*Interval{{0, 5, Closed}, {1, 2, Closed}, {3, 8, Closed}}, []*Interval{{0, 5, Closed}, {3, 8, Closed}}},
+		{[]*Interval{{1, 2, Closed}, {0, 5, Closed}}, []*Interval{{0, 5, Closed}}},
+		{[]*Interval{{0, 1, Closed}, {0, 1, Closed}, {0, 1, Closed}}, []*Interval{{0, 1, Closed}}},
+		{[]*Interval{{0, 1, Open}, {0, 1, Closed}}, []*Interval{{0, 1, Closed}}},
+		{[]*Interval{{0, 1, Closed}, {0, 1, Open}}, []*Interval{{0, 1, Closed}}},
+		{[]*Interval{{0, 1, LeftClosed}, {0, 1, RightClosed}}, []*Interval{{0, 1, LeftClosed}, {0, 1, RightClosed}}},
+		{[]*Interval{{4, 6, Closed}, {0, 1, Closed}, {2, 3, Open}}, []*Interval{{4, 6, Closed}, {0, 1, Closed}, {2, 3, Open}}},
+		{[]*Interval{{2, 3, Closed}, empty(), {neginf, inf, Open}, {5, 5, Closed}}, []*Interval{{neginf, inf, Open}}},
+	} {
+		if got := Simplify(test.ins); !slices.EqualFunc(got, test.want, Identical) {
+			t.Errorf("Simplify(%v): got %v, want %v", test.ins, got, test.want)
+		}
+	}
+}
+
+func TestAtomize(t *testing.T) {
+	for _, test := range []struct {
+		ins, want []*Interval
+	}{
+		{nil, nil},
+		{[]*Interval{empty()}, nil},
+		{
+			[]*Interval{{0, 1, Closed}},
+			[]*Interval{{0, 1, Closed}},
+		},
+		{
+			[]*Interval{{0, 3, Closed}, {1, 4, Closed}},
+			[]*Interval{{0, 1, LeftClosed}, {1, 3, Closed}, {3, 4, RightClosed}},
+		},
+		{
+			[]*Interval{{0, 2, Open}, {2, 4, Open}},
+			[]*Interval{{0, 2, Open}, {2, 4, Open}},
+		},
+		{
+			[]*Interval{{0, 2, RightClosed}, {2, 4, LeftClosed}},
+			[]*Interval{{0, 2, Open}, {2, 2, Closed}, {2, 4, Open}},
+		},
+		{
+			[]*Interval{{0, 5, Closed}, {1, 2, Open}, {0, 5, Closed}},
+			[]*Interval{{0, 1, Closed}, {1, 2, Open}, {2, 5, Closed}},
+		},
+		{
+			[]*Interval{{0, 4, Closed}, {2, 6, Open}, {3, 8, LeftClosed}},
+			[]*Interval{
+				{0, 2, Closed},
+				{2, 3, Open},
+				{3, 4, Closed},
+				{4, 6, Open},
+				{6, 8, LeftClosed},
+			},
+		},
+		{
+			[]*Interval{{neginf, 1, RightClosed}, {0, inf, Open}},
+			[]*Interval{{neginf, 0, RightClosed}, {0, 1, RightClosed}, {1, inf, Open}},
+		},
+		{
+			[]*Interval{{neginf, inf, Open}},
+			[]*Interval{{neginf, inf, Open}},
+		},
+	} {
+		got := Atomize(test.ins)
+		if !slices.EqualFunc(got, test.want, Equal) {
+			t.Errorf("Atomize(%v): got %v, want %v", test.ins, got, test.want)
+			continue
+		}
+		if len(got) == 0 {
+			continue
+		}
+		u := got[0]
+		for _, in := range got[1:] {
+			if in := Intersection(u, in); !in.IsEmpty() {
+				t.Errorf("Atomize(%v): atoms overlap at %v", test.ins, in)
+			}
+			u = Union(u, in)
+		}
+		want := empty()
+		for _, in := range test.ins {
+			if want.IsEmpty() {
+				want = in
+			} else {
+				want = Union(want, in)
+			}
+		}
+		if !Equal(u, want) {
+			t.Errorf("Atomize(%v): atoms reconstruct %v, want %v", test.ins, u, want)
+		}
+	}
+}
+
+var depthIns = []*Interval{
+	{0, 4, Closed},
+	{2, 6, Open},
+	{3, 8, LeftClosed},
+}
+
+func TestCoverageDepth(t *testing.T) {
+	for _, test := range []struct {
+		x    float64
+		want int
+	}{
+		{-1, 0},
+		{0, 1},
+		{2, 1},
+		{2.5, 2},
+		{3, 3},
+		{4, 3},
+		{5, 2},
+		{6, 1},
+		{8, 0},
+		{inf, 0},
+	} {
+		if got := CoverageDepth(depthIns, test.x); got != test.want {
+			t.Errorf("CoverageDepth(%v, %v): got %v, want %v", depthIns, test.x, got, test.want)
+		}
+	}
+}
+
+func TestDepthProfile(t *testing.T) {
+	want := []DepthSegment{
+		{&Interval{0, 2, Closed}, 1},
+		{&Interval{2, 3, Open}, 2},
+		{&Interval{3, 4, Closed}, 3},
+		{&Interval{4, 6, Open}, 2},
+		{&Interval{6, 8, LeftClosed}, 1},
+	}
+	got := DepthProfile(depthIns)
+	if len(got) != len(want) {
+		t.Fatalf("DepthProfile(%v): got %v, want %v", depthIns, got, want)
+	}
+	for i := range got {
+		if !Equal(got[i].Interval, want[i].Interval) || got[i].Depth != want[i].Depth {
+			t.Errorf("DepthProfile(%v)[%v]: got %v, want %v", depthIns, i, got[i], want[i])
+		}
+	}
+}
+
+func TestGaps(t *testing.T) {
+	for _, test := range []struct {
+		ins    []*Interval
+		within *Interval
+		want   IntervalSet
+	}{
+		{nil, empty(), nil},
+		{nil, &Interval{0, 10, Closed}, IntervalSet{{0, 10, Closed}}},
+		{[]*Interval{{0, 10, Closed}}, &Interval{0, 10, Closed}, nil},
+		{[]*Interval{{neginf, inf, Open}}, &Interval{0, 10, Closed}, nil},
+		{[]*Interval{{0, 10, Open}}, &Interval{0, 10, Closed}, IntervalSet{{0, 0, Closed}, {10, 10, Closed}}},
+		{
+			[]*Interval{{0, 3, Closed}, {5, 7, Closed}},
+			&Interval{0, 10, Closed},
+			IntervalSet{{3, 5, Open}, {7, 10, RightClosed}},
+		},
+		{
+			[]*Interval{{5, 7, Closed}, {2, 3, Open}},
+			&Interval{0, 10, Open},
+			IntervalSet{{0, 2, RightClosed}, {3, 5, LeftClosed}, {7, 10, Open}},
+		},
+		{
+			[]*Interval{{0, 4, Closed}, {3, 6, LeftClosed}},
+			&Interval{0, 10, Closed},
+			IntervalSet{{6, 10, Closed}},
+		},
+		{
+			[]*Interval{{0, 5, Closed}},
+			&Interval{neginf, inf, Open},
+			IntervalSet{{neginf, 0, Open}, {5, inf, Open}},
+		},
+	} {
+		if got := Gaps(test.ins, test.within); !slices.EqualFunc(got, test.want, Equal) {
+			t.Errorf("Gaps(%v, %v): got %v, want %v", test.ins, test.within, got, test.want)
+		}
+	}
+}
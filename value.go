@@ -0,0 +1,34 @@
+package interval
+
+// The methods in this file are value-based counterparts of the package's
+// pointer-based arithmetic and set functions. They take and return Interval
+// values, so that results can stay on the stack in loops that perform many
+// operations. Each returns a result equal to that of the function it mirrors.
+//
+// Because their results are not addressable, methods with pointer receivers
+// such as IsEmpty and String must be called on a variable holding the result
+// rather than on the method call itself.
+
+// Add returns the sum in+y, as Add(&in, &y) does.
+func (in Interval) Add(y Interval) Interval { return add(in, y) }
+
+// Sub returns the difference in-y, as Sub(&in, &y) does.
+func (in Interval) Sub(y Interval) Interval { return add(in, y.neg()) }
+
+// Mul returns the product in*y, as Mul(&in, &y) does.
+func (in Interval) Mul(y Interval) Interval { return mul(in, y) }
+
+// Div returns the quotient in/y and an error, as Div(&in, &y) does.
+func (in Interval) Div(y Interval) (Interval, error) {
+	q, err := div(in, y)
+	return q, divError(in, y, err)
+}
+
+// Negate returns the additive inverse of in, as in.Neg() does.
+func (in Interval) Negate() Interval { return in.neg() }
+
+// Intersect returns the intersection of in and y, as Intersection(&in, &y) does.
+func (in Interval) Intersect(y Interval) Interval { return intersection(in, y) }
+
+// Union returns the union of in and y, as Union(&in, &y) does.
+func (in Interval) Union(y Interval) Interval { return union(in, y) }
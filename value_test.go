@@ -0,0 +1,98 @@
+package interval
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestValueMethods(t *testing.T) {
+	ins := []*Interval{ine, inz, inp0, inp1, inm, inn0, inn1, inpi, inni, inr}
+	r := rand.New(rand.NewSource(1))
+	for range 200 {
+		ins = append(ins, randomIntInterval(r))
+	}
+	for _, x := range ins {
+		if got, want := x.Negate(), x.Neg(); !Identical(&got, want) {
+			t.Errorf("%v.Negate(): got %v, want %v", x, &got, want)
+		}
+		for _, y := range ins {
+			for _, op := range []struct {
+				name string
+				got  Interval
+				want *Interval
+			}{
+				{"Add", x.Add(*y), Add(x, y)},
+				{"Sub", x.Sub(*y), Sub(x, y)},
+				{"Mul", x.Mul(*y), Mul(x, y)},
+				{"Intersect", x.Intersect(*y), Intersection(x, y)},
+				{"Union", x.Union(*y), Union(x, y)},
+			} {
+				if !Identical(&op.got, op.want) {
+					t.Errorf("%v.%v(%v): got %v, want %v", x, op.name, y, &op.got, op.want)
+				}
+			}
+			got, gotErr := x.Div(*y)
+			want, wantErr := Div(x, y)
+			if !Identical(&got, want) || errors.Unwrap(gotErr) != errors.Unwrap(wantErr) {
+				t.Errorf("%v.Div(%v): got %v, %v, want %v, %v", x, y, &got, gotErr, want, wantErr)
+			}
+		}
+	}
+}
+
+var benchValue Interval
+
+func BenchmarkValue(b *testing.B) {
+	x, y := Interval{-2, -1, Closed}, Interval{3, 4, LeftClosed}
+	for _, bm := range []struct {
+		name string
+		f    func()
+	}{
+		{"Add", func() { benchValue = x.Add(y) }},
+		{"Mul", func() { benchValue = x.Mul(y) }},
+		{"Div", func() { benchValue, _ = x.Div(y) }},
+		{"Union", func() { benchValue = x.Union(y) }},
+		{"chain", func() {
+			acc := x
+			for range 8 {
+				acc = acc.Mul(y).Add(x)
+			}
+			benchValue = acc
+		}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				bm.f()
+			}
+		})
+	}
+}
+
+func BenchmarkPointer(b *testing.B) {
+	x, y := &Interval{-2, -1, Closed}, &Interval{3, 4, LeftClosed}
+	for _, bm := range []struct {
+		name string
+		f    func()
+	}{
+		{"Add", func() { benchResult = Add(x, y) }},
+		{"Mul", func() { benchResult = Mul(x, y) }},
+		{"Div", func() { benchResult, _ = Div(x, y) }},
+		{"Union", func() { benchResult = Union(x, y) }},
+		{"chain", func() {
+			acc := x
+			for range 8 {
+				acc = Add(Mul(acc, y), x)
+			}
+			benchResult = acc
+		}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				bm.f()
+			}
+		})
+	}
+}
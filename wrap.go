@@ -0,0 +1,83 @@
+package interval
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrPeriod is returned when NewWrap is called with a period
+// that is not positive and finite.
+var ErrPeriod = errors.New("non-positive or infinite period")
+
+// A WrapInterval is a closed arc of a circular domain, such as angles,
+// in which values that differ by a multiple of the period are identified.
+// The arc runs from lo in the increasing direction to hi,
+// and may wrap around the seam at the multiples of the period.
+// The WrapInterval type's zero value is not usable; use NewWrap.
+type WrapInterval struct {
+	// lo is in [0, period) and hi is in [lo, lo+period].
+	lo, hi, period float64
+}
+
+// NewWrap returns a pointer to the WrapInterval running from lo
+// in the increasing direction to hi, modulo period.
+// For example, NewWrap(350, 10, 360) is the arc of angles in degrees
+// from 350 through 0 to 10. If hi-lo is at least period,
+// the arc is the whole circle.
+// NewWrap returns a nil WrapInterval and ErrNaN if an argument is NaN,
+// ErrUnbounded if lo or hi is infinite,
+// or ErrPeriod if period is not positive and finite.
+func NewWrap(lo, hi, period float64) (*WrapInterval, error) {
+	switch {
+	case math.IsNaN(lo) || math.IsNaN(hi) || math.IsNaN(period):
+		return nil, ErrNaN
+	case math.IsInf(lo, 0) || math.IsInf(hi, 0):
+		return nil, ErrUnbounded
+	case !(period > 0) || math.IsInf(period, 1):
+		return nil, ErrPeriod
+	}
+	w := &WrapInterval{lo: mod(lo, period), period: period}
+	if hi-lo >= period {
+		w.hi = w.lo + period
+	} else {
+		w.hi = w.lo + mod(hi-lo, period)
+	}
+	return w, nil
+}
+
+// mod returns x modulo p in [0, p).
+func mod(x, p float64) float64 {
+	m := math.Mod(x, p)
+	if m < 0 {
+		m += p
+	}
+	if m == p {
+		// m was a tiny negative value that rounded up.
+		m = 0
+	}
+	return m
+}
+
+// Contains reports whether w contains x or a value that differs from x
+// by a multiple of w's period.
+func (w *WrapInterval) Contains(x float64) bool {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return false
+	}
+	x = mod(x, w.period)
+	return w.lo <= x && x <= w.hi || x <= w.hi-w.period
+}
+
+// Split returns the ordinary intervals in [0, period) that together
+// contain the same values as w, in increasing order.
+// It returns a single interval if w does not wrap around the seam
+// or is the whole circle, and two intervals otherwise.
+func (w *WrapInterval) Split() []*Interval {
+	switch {
+	case w.hi < w.period:
+		return []*Interval{{w.lo, w.hi, Closed}}
+	case w.hi-w.period >= w.lo:
+		return []*Interval{{0, w.period, LeftClosed}}
+	}
+	return []*Interval{{0, w.hi - w.period, Closed}, {w.lo, w.period, LeftClosed}}
+}
@@ -0,0 +1,95 @@
+package interval
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestNewWrap(t *testing.T) {
+	for _, test := range []struct {
+		lo, hi, period float64
+		err            error
+	}{
+		{math.NaN(), 10, 360, ErrNaN},
+		{0, inf, 360, ErrUnbounded},
+		{0, 10, 0, ErrPeriod},
+		{0, 10, -360, ErrPeriod},
+		{0, 10, inf, ErrPeriod},
+	} {
+		if w, err := NewWrap(test.lo, test.hi, test.period); w != nil || err != test.err {
+			t.Errorf("NewWrap(%v, %v, %v): got %v, %v; want <nil>, %v", test.lo, test.hi, test.period, w, err, test.err)
+		}
+	}
+}
+
+func TestWrapInterval(t *testing.T) {
+	for _, test := range []struct {
+		lo, hi, period float64
+		split          []*Interval
+		in, out        []float64
+	}{
+		{
+			10, 20, 360,
+			[]*Interval{{10, 20, Closed}},
+			[]float64{10, 15, 20, 370, -345},
+			[]float64{0, 9, 21, 359, 360, -330},
+		},
+		{
+			350, 10, 360,
+			[]*Interval{{0, 10, Closed}, {350, 360, LeftClosed}},
+			[]float64{350, 355, 359.5, 0, 360, -360, 5, 10, -5, 720},
+			[]float64{11, 180, 349, -11},
+		},
+		{
+			-10, 10, 360,
+			[]*Interval{{0, 10, Closed}, {350, 360, LeftClosed}},
+			[]float64{350, 0, 360, 10},
+			[]float64{11, 349},
+		},
+		{
+			350, 360, 360,
+			[]*Interval{{0, 0, Closed}, {350, 360, LeftClosed}},
+			[]float64{350, 0, 360},
+			[]float64{1, 349},
+		},
+		{
+			5, 5, 360,
+			[]*Interval{{5, 5, Closed}},
+			[]float64{5, 365},
+			[]float64{4, 6},
+		},
+		{
+			90, 450, 360,
+			[]*Interval{{0, 360, LeftClosed}},
+			[]float64{0, 90, 180, 359, 360},
+			nil,
+		},
+		{
+			0.5, 0.25, 1,
+			[]*Interval{{0, 0.25, Closed}, {0.5, 1, LeftClosed}},
+			[]float64{0.5, 0.75, 1, 0, 0.25},
+			[]float64{0.3, 0.49, math.NaN(), inf},
+		},
+	} {
+		w, err := NewWrap(test.lo, test.hi, test.period)
+		if err != nil {
+			t.Errorf("NewWrap(%v, %v, %v): got %v", test.lo, test.hi, test.period, err)
+			continue
+		}
+		split := w.Split()
+		if !slices.EqualFunc(split, test.split, Equal) {
+			t.Errorf("NewWrap(%v, %v, %v).Split(): got %v, want %v", test.lo, test.hi, test.period, split, test.split)
+		}
+		for _, x := range test.in {
+			if !w.Contains(x) {
+				t.Errorf("NewWrap(%v, %v, %v).Contains(%v): got false, want true", test.lo, test.hi, test.period, x)
+			}
+		}
+		for _, x := range test.out {
+			if w.Contains(x) {
+				t.Errorf("NewWrap(%v, %v, %v).Contains(%v): got true, want false", test.lo, test.hi, test.period, x)
+			}
+		}
+	}
+}